@@ -0,0 +1,15 @@
+// Command justest-vet runs the justest static analyzers, and can be used as a "go vet" tool:
+//
+//	go install github.com/arikkfir/justest/analysis/cmd/justest-vet@latest
+//	go vet -vettool=$(which justest-vet) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/arikkfir/justest/analysis/unevaluated"
+)
+
+func main() {
+	singlechecker.Main(unevaluated.Analyzer)
+}
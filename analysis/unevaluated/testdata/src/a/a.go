@@ -0,0 +1,65 @@
+package a
+
+import (
+	"time"
+
+	. "github.com/arikkfir/justest"
+)
+
+func evaluated(t T) {
+	With(t).VerifyThat(1).Will(EqualTo(1)).Now()
+	With(t).VerifyThat(1).Will(EqualTo(1)).Within(time.Second, 100*time.Millisecond)
+
+	a := With(t).VerifyThat(1).Will(EqualTo(1))
+	a.For(time.Second, 100*time.Millisecond)
+}
+
+func unevaluated(t T) {
+	With(t).VerifyThat(1).Will(EqualTo(1)) // want `assertion is never evaluated`
+
+	_ = With(t).VerifyThat(1).Will(EqualTo(1)) // want `assertion is never evaluated`
+
+	a := With(t).VerifyThat(1).Will(EqualTo(1)) // want `assertion is never evaluated`
+	_ = a
+}
+
+func evaluatedTwice(t T) {
+	a := With(t).VerifyThat(1).Will(EqualTo(1))
+	a.Now()
+	a.Now() // want `assertion a is evaluated more than once`
+
+	var b = With(t).VerifyThat(1).Will(EqualTo(1))
+	b.Now()
+	b.Within(time.Second, 100*time.Millisecond) // want `assertion b is evaluated more than once`
+}
+
+func evaluatedInBranches(t T, cond bool) {
+	a := With(t).VerifyThat(1).Will(EqualTo(1))
+	if cond {
+		a.Now()
+	} else {
+		a.For(time.Second, 100*time.Millisecond)
+	}
+}
+
+func reassigned(t T) {
+	a := With(t).VerifyThat(1).Will(EqualTo(1))
+	a.Now()
+	a = With(t).VerifyThat(2).Will(EqualTo(2))
+	a.Now()
+}
+
+func escaping(t T) Assertion {
+	a := With(t).VerifyThat(1).Will(EqualTo(1))
+	evaluate(a)
+	b := With(t).VerifyThat(1).Will(EqualTo(1))
+	return b
+}
+
+func evaluate(a Assertion) {
+	a.Now()
+}
+
+func returned(t T) Assertion {
+	return With(t).VerifyThat(1).Will(EqualTo(1))
+}
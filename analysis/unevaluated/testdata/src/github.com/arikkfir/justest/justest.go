@@ -0,0 +1,31 @@
+// Package justest is a minimal stub of the justest API used by the analyzer's tests.
+package justest
+
+import "time"
+
+type T interface {
+	Fatalf(format string, args ...any)
+}
+
+type Matcher interface {
+	Assert(t T, actuals ...any)
+}
+
+type VerifyOrEnsure interface {
+	VerifyThat(actuals ...any) Asserter
+}
+
+type Asserter interface {
+	Will(m Matcher) Assertion
+}
+
+type Assertion interface {
+	Now()
+	OrFail()
+	For(duration time.Duration, interval time.Duration)
+	Within(duration time.Duration, interval time.Duration)
+}
+
+func With(t T) VerifyOrEnsure { return nil }
+
+func EqualTo(expected any) Matcher { return nil }
@@ -0,0 +1,198 @@
+// Package unevaluated defines an Analyzer that reports justest assertions that are never evaluated, or that are
+// evaluated more than once.
+//
+// An assertion is created by "With(t).VerifyThat(...).Will(...)", but is only performed once one of its evaluation
+// methods (Now, For, Within, etc.) is called. Forgetting to call one of them silently skips the assertion (it is only
+// reported at runtime, when the test ends, and only if the test has not already failed), while calling them twice
+// panics with "assertion already evaluated".
+//
+// The analyzer can be used with "go vet" via the "justest-vet" command:
+//
+//	go install github.com/arikkfir/justest/analysis/cmd/justest-vet@latest
+//	go vet -vettool=$(which justest-vet) ./...
+package unevaluated
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const (
+	justestPkgPath    = "github.com/arikkfir/justest"
+	assertionTypeName = "Assertion"
+)
+
+const Doc = `check for justest assertions that are never evaluated, or evaluated more than once
+
+An assertion created by "With(t).VerifyThat(...).Will(...)" is only performed once one of its evaluation methods (Now,
+For, Within, etc.) is called. This analyzer reports assertions whose evaluation method is never called, as well as
+assertions evaluated more than once, which panics at runtime.`
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "unevaluated",
+	Doc:      Doc,
+	URL:      "https://pkg.go.dev/github.com/arikkfir/justest/analysis/unevaluated",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	in := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	// Assertions discarded immediately, e.g. "With(t).VerifyThat(1).Will(EqualTo(1))" or "_ = ...Will(...)"
+	in.Preorder([]ast.Node{(*ast.ExprStmt)(nil), (*ast.AssignStmt)(nil)}, func(n ast.Node) {
+		switch stmt := n.(type) {
+		case *ast.ExprStmt:
+			if isAssertion(pass, stmt.X) {
+				reportUnevaluated(pass, stmt.X)
+			}
+		case *ast.AssignStmt:
+			if len(stmt.Lhs) == len(stmt.Rhs) {
+				for i, lhs := range stmt.Lhs {
+					if _, isVar := stmt.Rhs[i].(*ast.Ident); !isVar && isBlank(lhs) && isAssertion(pass, stmt.Rhs[i]) {
+						reportUnevaluated(pass, stmt.Rhs[i])
+					}
+				}
+			}
+		}
+	})
+
+	// Assertions stored in local variables, e.g. "a := ...Will(...)"
+	vars := make(map[*types.Var]*assertionVar)
+	in.WithStack([]ast.Node{(*ast.Ident)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		ident := n.(*ast.Ident)
+		if v, ok := pass.TypesInfo.Defs[ident].(*types.Var); ok && isAssertionType(v.Type()) {
+			if value := definitionValue(ident, stack); value != nil {
+				vars[v] = &assertionVar{ident: ident, value: value}
+			}
+		} else if v, ok := pass.TypesInfo.Uses[ident].(*types.Var); ok && isAssertionType(v.Type()) {
+			if av, ok := vars[v]; ok {
+				av.use(ident, stack)
+			}
+		}
+		return true
+	})
+	for _, av := range vars {
+		if av.escapes {
+			continue
+		} else if len(av.evaluations) == 0 {
+			reportUnevaluated(pass, av.value)
+		} else if !av.reassigned {
+			av.reportRepeatedEvaluations(pass)
+		}
+	}
+
+	return nil, nil
+}
+
+// evaluation is a call to one of the methods of an assertion variable, as a statement in the given block (or case
+// clause).
+type evaluation struct {
+	stmt  *ast.ExprStmt
+	block ast.Node
+}
+
+// assertionVar tracks the usages of a local variable holding an assertion.
+type assertionVar struct {
+	ident       *ast.Ident
+	value       ast.Expr
+	evaluations []evaluation
+	escapes     bool
+	reassigned  bool
+}
+
+// use records a usage of the variable: a call to one of its methods is an evaluation, an assignment to it is a
+// reassignment, assigning it to "_" is ignored, and any other usage (passing it to a function, returning it, etc.) means it escapes this analysis.
+func (av *assertionVar) use(ident *ast.Ident, stack []ast.Node) {
+	parent := stack[len(stack)-2]
+	if sel, ok := parent.(*ast.SelectorExpr); ok && sel.X == ident && len(stack) >= 4 {
+		if call, ok := stack[len(stack)-3].(*ast.CallExpr); ok && call.Fun == sel {
+			if stmt, ok := stack[len(stack)-4].(*ast.ExprStmt); ok && len(stack) >= 5 {
+				av.evaluations = append(av.evaluations, evaluation{stmt: stmt, block: stack[len(stack)-5]})
+				return
+			}
+		}
+	} else if assign, ok := parent.(*ast.AssignStmt); ok {
+		for i, lhs := range assign.Lhs {
+			if lhs == ident {
+				av.reassigned = true
+				return
+			} else if len(assign.Lhs) == len(assign.Rhs) && assign.Rhs[i] == ident && isBlank(lhs) {
+				// Assigning to "_" does not use the assertion, e.g. "_ = a" to silence the "declared and not used" error
+				return
+			}
+		}
+	}
+	av.escapes = true
+}
+
+// reportRepeatedEvaluations reports evaluations that follow an earlier evaluation in the same block.
+func (av *assertionVar) reportRepeatedEvaluations(pass *analysis.Pass) {
+	seen := make(map[ast.Node]bool)
+	for _, e := range av.evaluations {
+		if seen[e.block] {
+			pass.ReportRangef(e.stmt, "assertion %s is evaluated more than once, which panics with \"assertion already evaluated\"", av.ident.Name)
+		} else {
+			seen[e.block] = true
+		}
+	}
+}
+
+func reportUnevaluated(pass *analysis.Pass, expr ast.Expr) {
+	pass.ReportRangef(expr, "assertion is never evaluated; call Now, For or Within (or one of their variants) to perform it")
+}
+
+// isAssertion returns true if the given expression is a justest.Assertion value.
+func isAssertion(pass *analysis.Pass, expr ast.Expr) bool {
+	return isAssertionType(pass.TypesInfo.TypeOf(expr))
+}
+
+// isAssertionType returns true if the given type is justest.Assertion.
+func isAssertionType(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == justestPkgPath && obj.Name() == assertionTypeName
+}
+
+func isBlank(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "_"
+}
+
+// definitionValue returns the value assigned to the given identifier by its definition (e.g. "a := value" or
+// "var a = value"), if any.
+func definitionValue(ident *ast.Ident, stack []ast.Node) ast.Expr {
+	switch parent := stack[len(stack)-2].(type) {
+	case *ast.AssignStmt:
+		if parent.Tok == token.DEFINE && len(parent.Lhs) == len(parent.Rhs) {
+			for i, lhs := range parent.Lhs {
+				if lhs == ident {
+					return parent.Rhs[i]
+				}
+			}
+		}
+	case *ast.ValueSpec:
+		if len(parent.Names) == len(parent.Values) {
+			for i, name := range parent.Names {
+				if name == ident {
+					return parent.Values[i]
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package unevaluated_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/arikkfir/justest/analysis/unevaluated"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), unevaluated.Analyzer, "a")
+}
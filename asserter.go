@@ -1,7 +1,9 @@
 package justest
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"path/filepath"
 	"regexp"
 	"time"
@@ -17,7 +19,11 @@ func With(t T) VerifyOrEnsure {
 		panic("given T instance must not be nil")
 	}
 	GetHelper(t).Helper()
-	return &verifier{t: t}
+	v := &verifier{t: t, clock: RealClock}
+	if a := nearestAssertion(t); a != nil {
+		v.clock, v.slowedBy = a.clock, a.slowedBy
+	}
+	return v
 }
 
 type VerifyOrEnsure interface {
@@ -32,15 +38,33 @@ type VerifyOrEnsure interface {
 
 	// Deprecated: Verify is a synonym for VerifyThat.
 	Verify(actuals ...any) Asserter
+
+	// Softly invokes the given function with a T that records assertion failures instead of failing the test
+	// immediately. Each failed assertion inside the function is recorded and the function continues to its next
+	// statement; once the function returns, all recorded failures are reported together.
+	Softly(f func(t T))
+
+	// UsingClock makes upcoming timed assertions (For and Within) use the given clock for their deadlines and polling
+	// intervals, instead of the real clock.
+	UsingClock(c Clock) VerifyOrEnsure
+
+	// SlowedBy scales the durations and intervals of upcoming timed assertions (For and Within) by the given factor,
+	// overriding the factor configured by the JUSTEST_SLOW_FACTOR environment variable.
+	SlowedBy(factor float64) VerifyOrEnsure
 }
 
 type Ensurer interface {
 	ByVerifying(actuals ...any) Asserter
+
+	// Softly is the same as VerifyOrEnsure.Softly, but prefixes the reported failures with the description.
+	Softly(f func(t T))
 }
 
 type verifier struct {
-	t    T
-	desc string
+	t        T
+	desc     string
+	clock    Clock
+	slowedBy float64
 }
 
 //go:noinline
@@ -60,19 +84,52 @@ func (v *verifier) Ensure(format string, args ...any) Ensurer {
 //go:noinline
 func (v *verifier) ByVerifying(actuals ...any) Asserter {
 	GetHelper(v.t).Helper()
-	return &asserter{t: v.t, desc: v.desc, actuals: actuals}
+	return &asserter{t: v.t, desc: v.desc, clock: v.clock, slowedBy: v.slowedBy, actuals: actuals}
 }
 
 //go:noinline
 func (v *verifier) VerifyThat(actuals ...any) Asserter {
 	GetHelper(v.t).Helper()
-	return &asserter{t: v.t, desc: v.desc, actuals: actuals}
+	return &asserter{t: v.t, desc: v.desc, clock: v.clock, slowedBy: v.slowedBy, actuals: actuals}
 }
 
 //go:noinline
 func (v *verifier) Verify(actuals ...any) Asserter {
 	GetHelper(v.t).Helper()
-	return &asserter{t: v.t, desc: v.desc, actuals: actuals}
+	return &asserter{t: v.t, desc: v.desc, clock: v.clock, slowedBy: v.slowedBy, actuals: actuals}
+}
+
+//go:noinline
+func (v *verifier) Softly(f func(t T)) {
+	GetHelper(v.t).Helper()
+
+	st := &softT{parent: v.t}
+	func() {
+		GetHelper(v.t).Helper()
+		defer st.recoverFailure()
+		f(st)
+	}()
+	st.report(v.desc)
+}
+
+//go:noinline
+func (v *verifier) UsingClock(c Clock) VerifyOrEnsure {
+	GetHelper(v.t).Helper()
+	if c == nil {
+		panic("given clock must not be nil")
+	}
+	v.clock = c
+	return v
+}
+
+//go:noinline
+func (v *verifier) SlowedBy(factor float64) VerifyOrEnsure {
+	GetHelper(v.t).Helper()
+	if factor <= 0 || math.IsInf(factor, 0) || math.IsNaN(factor) {
+		panic(fmt.Sprintf("expected a positive slow factor, got: %v", factor))
+	}
+	v.slowedBy = factor
+	return v
 }
 
 type Asserter interface {
@@ -80,9 +137,11 @@ type Asserter interface {
 }
 
 type asserter struct {
-	t       T
-	actuals []any
-	desc    string
+	t        T
+	actuals  []any
+	desc     string
+	clock    Clock
+	slowedBy float64
 }
 
 //go:noinline
@@ -92,6 +151,8 @@ func (a *asserter) Will(m Matcher) Assertion {
 	aa := &assertion{
 		t:        a.t,
 		desc:     a.desc,
+		clock:    a.clock,
+		slowedBy: a.slowedBy,
 		location: nearestLocation(),
 		actuals:  a.actuals,
 		matcher:  m,
@@ -121,6 +182,22 @@ type Assertion interface {
 	// Within will continually perform the assertion until the given duration has passed or until it successfully
 	// matches. If the duration has passed without any successful matches, the assertion is considered failed.
 	Within(duration time.Duration, interval time.Duration)
+
+	// ForUsing is the same as For, but waits between attempts according to the given polling strategy. If the strategy
+	// stops before the duration has passed, the assertion passes as long as it never mismatched.
+	ForUsing(duration time.Duration, strategy PollingStrategy)
+
+	// WithinUsing is the same as Within, but waits between attempts according to the given polling strategy. If the
+	// strategy stops before the assertion successfully matched, the assertion is considered failed.
+	WithinUsing(duration time.Duration, strategy PollingStrategy)
+
+	// ForContext is the same as For, but performs the assertion until the given context is done, instead of for a
+	// fixed duration. The context is available to the matcher's T via GetContext.
+	ForContext(ctx context.Context, interval time.Duration)
+
+	// WithinContext is the same as Within, but performs the assertion until the given context is done, instead of for
+	// a fixed duration. If the context is done without any successful matches, the assertion is considered failed.
+	WithinContext(ctx context.Context, interval time.Duration)
 }
 
 type assertion struct {
@@ -128,15 +205,18 @@ type assertion struct {
 	location  Location
 	actuals   []any
 	matcher   Matcher
-	contain   bool
-	cleanup   []func()
 	evaluated bool
 	desc      string
+	clock     Clock
+	slowedBy  float64
 }
 
 //go:noinline
 func (a *assertion) OrFail() {
 	GetHelper(a.t).Helper()
+	if st, ok := a.t.(*softT); ok {
+		defer st.recoverFailure()
+	}
 	if a.evaluated {
 		panic("assertion already evaluated")
 	} else {
@@ -148,6 +228,9 @@ func (a *assertion) OrFail() {
 //go:noinline
 func (a *assertion) Now() {
 	GetHelper(a.t).Helper()
+	if st, ok := a.t.(*softT); ok {
+		defer st.recoverFailure()
+	}
 	if a.evaluated {
 		panic("assertion already evaluated")
 	} else {
@@ -159,7 +242,43 @@ func (a *assertion) Now() {
 //go:noinline
 func (a *assertion) For(duration time.Duration, interval time.Duration) {
 	GetHelper(a.t).Helper()
-	duration = transformDurationIfNecessary(a.t, duration)
+	a.ForUsing(duration, FixedInterval(interval))
+}
+
+//go:noinline
+func (a *assertion) ForUsing(duration time.Duration, strategy PollingStrategy) {
+	GetHelper(a.t).Helper()
+	if strategy == nil {
+		panic("given polling strategy must not be nil")
+	}
+	if st, ok := a.t.(*softT); ok {
+		defer st.recoverFailure()
+	}
+
+	if a.evaluated {
+		panic("assertion already evaluated")
+	} else {
+		a.evaluated = true
+	}
+
+	a.poll(GetContext(a.t), duration, strategy, pollUntilMismatch)
+}
+
+//go:noinline
+func (a *assertion) Within(duration time.Duration, interval time.Duration) {
+	GetHelper(a.t).Helper()
+	a.WithinUsing(duration, FixedInterval(interval))
+}
+
+//go:noinline
+func (a *assertion) WithinUsing(duration time.Duration, strategy PollingStrategy) {
+	GetHelper(a.t).Helper()
+	if strategy == nil {
+		panic("given polling strategy must not be nil")
+	}
+	if st, ok := a.t.(*softT); ok {
+		defer st.recoverFailure()
+	}
 
 	if a.evaluated {
 		panic("assertion already evaluated")
@@ -167,86 +286,37 @@ func (a *assertion) For(duration time.Duration, interval time.Duration) {
 		a.evaluated = true
 	}
 
-	timer := time.NewTimer(duration)
-	defer timer.Stop()
-
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
-	ticking := false
-	cleaningUp := false
-	var failure *internal.FormatAndArgs
-	succeeded := false
-	tick := func() {
-		GetHelper(a).Helper()
-
-		// Notify we're no longer in a "tick"
-		defer func() { ticking = false }()
-
-		// Contain the potential "Fatal" calls from this tick as failures
-		defer func() {
-			if r := recover(); r != nil {
-				if fa, ok := r.(internal.FormatAndArgs); ok {
-					failure = &fa
-				} else if !a.Failed() {
-					panic(r)
-				}
-			} else {
-				succeeded = true
-			}
-		}()
-
-		// Perform cleanups for this tick
-		a.cleanup = nil
-		defer func() {
-			cleaningUp = true
-			defer func() { cleaningUp = false }()
-
-			// TODO: decide what to do with failures during cleanups
-			for i := len(a.cleanup) - 1; i >= 0; i-- {
-				a.cleanup[i]()
-			}
-		}()
-
-		a.matcher.Assert(a, a.actuals...)
+	a.poll(GetContext(a.t), duration, strategy, pollUntilMatch)
+}
+
+//go:noinline
+func (a *assertion) ForContext(ctx context.Context, interval time.Duration) {
+	GetHelper(a.t).Helper()
+	if ctx == nil {
+		panic("given context must not be nil")
+	}
+	if st, ok := a.t.(*softT); ok {
+		defer st.recoverFailure()
 	}
 
-	a.contain = true
-	started := time.Now()
-	for {
-		select {
-		case <-timer.C:
-			for cleaningUp {
-				time.Sleep(50 * time.Millisecond)
-			}
-			a.contain = false
-			if failure != nil {
-				a.Fatalf("%s\nAssertion failed while waiting for %s", failure, duration)
-			} else if !succeeded {
-				a.Fatalf("Timed out after %s waiting for assertion to pass (tick never finished once)", duration)
-			} else {
-				return
-			}
-		case <-ticker.C:
-			verifyNotInterrupted(a.t)
-			if failure != nil {
-				for cleaningUp {
-					time.Sleep(50 * time.Millisecond)
-				}
-				a.contain = false
-				a.Fatalf("%s\nAssertion failed after %s and did not pass repeatedly for %s", failure, time.Since(started), duration)
-			} else if !ticking {
-				ticking = true
-				go tick()
-			}
-		}
+	if a.evaluated {
+		panic("assertion already evaluated")
+	} else {
+		a.evaluated = true
 	}
+
+	a.poll(ctx, 0, FixedInterval(interval), pollUntilMismatch)
 }
 
 //go:noinline
-func (a *assertion) Within(duration time.Duration, interval time.Duration) {
+func (a *assertion) WithinContext(ctx context.Context, interval time.Duration) {
 	GetHelper(a.t).Helper()
-	duration = transformDurationIfNecessary(a.t, duration)
+	if ctx == nil {
+		panic("given context must not be nil")
+	}
+	if st, ok := a.t.(*softT); ok {
+		defer st.recoverFailure()
+	}
 
 	if a.evaluated {
 		panic("assertion already evaluated")
@@ -254,81 +324,25 @@ func (a *assertion) Within(duration time.Duration, interval time.Duration) {
 		a.evaluated = true
 	}
 
-	timer := time.NewTimer(duration)
-	defer timer.Stop()
-
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
-	ticking := false
-	cleaningUp := false
-	var failure *internal.FormatAndArgs
-	succeeded := false
-	tick := func() {
-		GetHelper(a).Helper()
-
-		// Notify we're no longer in a "tick"
-		defer func() { ticking = false }()
-
-		// Contain the potential "Fatal" calls from this tick as failures
-		defer func() {
-			if r := recover(); r != nil {
-				if fa, ok := r.(internal.FormatAndArgs); ok {
-					failure = &fa
-				} else if !a.Failed() {
-					panic(r)
-				}
-			} else {
-				succeeded = true
-			}
-		}()
-
-		// Perform cleanups for this tick
-		a.cleanup = nil
-		defer func() {
-			cleaningUp = true
-			defer func() { cleaningUp = false }()
-
-			// TODO: decide what to do with failures during cleanups
-			for i := len(a.cleanup) - 1; i >= 0; i-- {
-				a.cleanup[i]()
-			}
-		}()
-
-		a.matcher.Assert(a, a.actuals...)
+	a.poll(ctx, 0, FixedInterval(interval), pollUntilMatch)
+}
+
+// slowFactor returns the factor by which the durations and intervals of this assertion are scaled: the factor given to
+// VerifyOrEnsure.SlowedBy if any, or the factor configured by the JUSTEST_SLOW_FACTOR environment variable.
+func (a *assertion) slowFactor() float64 {
+	if a.slowedBy > 0 {
+		return a.slowedBy
 	}
+	return envSlowFactor(a.t)
+}
 
-	a.contain = true
-	started := time.Now()
-	for {
-		select {
-		case <-timer.C:
-			for cleaningUp {
-				time.Sleep(50 * time.Millisecond)
-			}
-			if succeeded {
-				return
-			}
-
-			a.contain = false
-			if failure != nil {
-				a.Fatalf("%s\nTimed out after %s waiting for assertion to pass", failure, time.Since(started))
-			} else {
-				a.Fatalf("Timed out after %s waiting for assertion to pass (tick never finished once)", duration)
-			}
-		case <-ticker.C:
-			verifyNotInterrupted(a.t)
-			if succeeded {
-				for cleaningUp {
-					time.Sleep(50 * time.Millisecond)
-				}
-				return
-			} else if !ticking {
-				ticking = true
-				go tick()
-			}
-		}
+// expectation returns a description of the assertion's expectation, if its matcher is a DescribedMatcher, or an empty
+// string otherwise.
+func (a *assertion) expectation() string {
+	if dm, ok := a.matcher.(DescribedMatcher); ok {
+		return fmt.Sprintf("\nExpected %s %s", describeValues(a.actuals), dm.Describe())
 	}
+	return ""
 }
 
 //go:noinline
@@ -339,11 +353,7 @@ func (a *assertion) Name() string {
 //go:noinline
 func (a *assertion) Cleanup(f func()) {
 	GetHelper(a).Helper()
-	if a.contain {
-		a.cleanup = append(a.cleanup, f)
-	} else {
-		a.t.Cleanup(f)
-	}
+	a.t.Cleanup(f)
 }
 
 //go:noinline
@@ -360,28 +370,24 @@ func (a *assertion) Fatalf(format string, args ...any) {
 		format = fmt.Sprintf("Assertion that %s failed: %s", a.desc, format)
 	}
 
-	if a.contain {
-		panic(internal.FormatAndArgs{Format: &format, Args: args})
-	} else {
-		caller := internal.CallerAt(1)
-		callerFunction, callerFile, callerLine := caller.Location()
-
-		// Check if direct caller is from within the "justest" package; if NOT (application test code) print the caller
-		if internalCall, err := regexp.MatchString(`.*/arikkfir/justest\.`, callerFunction); err != nil {
-			panic(fmt.Errorf("illegal regexp matching: %+v", err))
-		} else if !internalCall {
-			// Direct caller is NOT from the "justest" package; thus we also print the caller, in addition to the
-			// location of the actual assertion (which is always printed)
-			format = format + "\n%s:%d --> %s"
-			args = append(args, filepath.Base(callerFile), callerLine, indentIfMultiLine(readSourceAt(callerFile, callerLine)))
-		}
+	caller := internal.CallerAt(1)
+	callerFunction, callerFile, callerLine := caller.Location()
 
-		// Always print the assertion location
+	// Check if direct caller is from within the "justest" package; if NOT (application test code) print the caller
+	if internalCall, err := regexp.MatchString(`.*/arikkfir/justest\.`, callerFunction); err != nil {
+		panic(fmt.Errorf("illegal regexp matching: %+v", err))
+	} else if !internalCall {
+		// Direct caller is NOT from the "justest" package; thus we also print the caller, in addition to the
+		// location of the actual assertion (which is always printed)
 		format = format + "\n%s:%d --> %s"
-		args = append(args, filepath.Base(a.location.File), a.location.Line, indentIfMultiLine(a.location.Source))
-
-		a.t.Fatalf(format, args...)
+		args = append(args, filepath.Base(callerFile), callerLine, indentIfMultiLine(readSourceAt(callerFile, callerLine)))
 	}
+
+	// Always print the assertion location
+	format = format + "\n%s:%d --> %s"
+	args = append(args, filepath.Base(a.location.File), a.location.Line, indentIfMultiLine(a.location.Source))
+
+	a.t.Fatalf(format, args...)
 }
 
 //go:noinline
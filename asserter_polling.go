@@ -0,0 +1,369 @@
+package justest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/arikkfir/justest/internal"
+)
+
+// pollingMode determines when a timed assertion stops polling.
+type pollingMode int
+
+const (
+	// pollUntilMismatch keeps polling until the matcher fails, or time runs out (used by For).
+	pollUntilMismatch pollingMode = iota
+
+	// pollUntilMatch keeps polling until the matcher succeeds, or time runs out (used by Within).
+	pollUntilMatch
+)
+
+// pollingOutcome is the reason a timed assertion stopped polling.
+type pollingOutcome int
+
+const (
+	// pollingDecided means an attempt decided the assertion: a failure for pollUntilMismatch, or a success for
+	// pollUntilMatch.
+	pollingDecided pollingOutcome = iota
+
+	// pollingExpired means the assertion duration has passed.
+	pollingExpired
+
+	// pollingExhausted means the polling strategy allowed no further attempts.
+	pollingExhausted
+
+	// pollingStopped means the assertion context was done, either by the caller or due to the test deadline.
+	pollingStopped
+)
+
+// TestDeadlineMargin is how long before the test deadline (see testing.T.Deadline) timed assertions stop polling, so
+// that they fail with a clear message instead of the test binary being killed by the "go test" timeout.
+var TestDeadlineMargin = 5 * time.Second
+
+// virtualClock is a Clock whose time only moves when advanced (e.g. FakeClock); timed assertions advance such clocks
+// to their next attempt, instead of waiting for it.
+type virtualClock interface {
+	Clock
+	Advance(d time.Duration)
+}
+
+// errTestDeadline is the cause of the assertion context being done when the test deadline is approaching.
+var errTestDeadline = errors.New("test deadline is approaching")
+
+// poll is the engine behind timed assertions. It performs attempts of the assertion, each in its own goroutine and with
+// its own tickT, waiting between attempts according to the given strategy, until either an attempt decides the
+// assertion, the duration passes (unless it is zero), the context is done, or the strategy is exhausted. The duration
+// and the strategy's intervals are scaled by the assertion's slow factor, and the context is capped at the test
+// deadline, minus TestDeadlineMargin. An attempt that is still running when polling stops is abandoned: it may finish
+// in the background, but its outcome is ignored, unless it had already finished asserting and was only running its
+// cleanups, in which case those are awaited and its outcome is considered.
+//
+//go:noinline
+func (a *assertion) poll(ctx context.Context, duration time.Duration, strategy PollingStrategy, mode pollingMode) {
+	GetHelper(a.t).Helper()
+
+	factor := a.slowFactor()
+	details := describeSlowdown(duration, factor) + a.expectation()
+	duration = scaleDuration(duration, factor)
+	strategy = slowedBy(strategy, factor)
+
+	if deadline, ok := getDeadline(a.t); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadlineCause(ctx, deadline.Add(-TestDeadlineMargin), errTestDeadline)
+		defer cancel()
+	}
+
+	var expired <-chan time.Time
+	if duration > 0 {
+		deadline := a.clock.NewTimer(duration)
+		defer deadline.Stop()
+		expired = deadline.C()
+	}
+
+	var failure *internal.FormatAndArgs
+	succeeded := false
+	attempts := 0
+	started := a.clock.Now()
+
+	// record stores the result of a finished attempt, and returns true if it decided the assertion
+	record := func(result tickResult) bool {
+		if result.failure != nil {
+			failure = result.failure
+			return mode == pollUntilMismatch
+		} else if result.succeeded {
+			succeeded = true
+			return mode == pollUntilMatch
+		}
+		return false
+	}
+
+	// abandon stops waiting for the given attempt, and returns the given outcome, unless the attempt was already
+	// cleaning up and its result decided the assertion
+	abandon := func(tt *tickT, outcome pollingOutcome) pollingOutcome {
+		if tt.abandon() {
+			// Attempt finished asserting & is cleaning up; wait for it and consider its result
+			if record(<-tt.done) {
+				return pollingDecided
+			}
+		}
+		return outcome
+	}
+
+	outcome := func() pollingOutcome {
+		for {
+			interval, ok := strategy.Next(attempts + 1)
+			if !ok {
+				return pollingExhausted
+			}
+
+			// Wait for the next attempt, unless the duration passes or the context is done in the meantime
+			wait := a.clock.NewTimer(interval)
+			if vc, ok := a.clock.(virtualClock); ok {
+				vc.Advance(interval)
+			}
+			select {
+			case <-expired:
+				wait.Stop()
+				return pollingExpired
+			case <-ctx.Done():
+				wait.Stop()
+				return pollingStopped
+			case <-wait.C():
+			}
+
+			// The duration may have passed along with the interval (e.g. when advancing a virtual clock)
+			select {
+			case <-expired:
+				return pollingExpired
+			case <-ctx.Done():
+				return pollingStopped
+			default:
+			}
+
+			verifyNotInterrupted(a.t)
+			attempts++
+			tt := newTickT(ctx, a)
+			go tt.run()
+
+			select {
+			case <-expired:
+				return abandon(tt, pollingExpired)
+			case <-ctx.Done():
+				return abandon(tt, pollingStopped)
+			case result := <-tt.done:
+				if record(result) {
+					return pollingDecided
+				}
+			}
+		}
+	}()
+
+	elapsed := a.clock.Now().Sub(started)
+	cause := context.Cause(ctx)
+	switch mode {
+	case pollUntilMismatch:
+		if outcome == pollingDecided {
+			a.Fatalf("%s\nAssertion failed after %s and did not pass repeatedly for %s%s", failure, elapsed, a.describeDuration(duration), details)
+		} else if outcome == pollingStopped && errors.Is(cause, errTestDeadline) {
+			a.Fatalf("Stopped after %s waiting for assertion to pass repeatedly for %s: %s%s", elapsed, a.describeDuration(duration), cause, details)
+		} else if !succeeded && outcome == pollingStopped {
+			a.Fatalf("Stopped after %s waiting for assertion to pass (tick never finished once): %s%s", elapsed, cause, details)
+		} else if !succeeded {
+			a.Fatalf("Timed out after %s waiting for assertion to pass (tick never finished once)%s", duration, details)
+		}
+	case pollUntilMatch:
+		if outcome == pollingDecided {
+			return
+		} else if outcome == pollingStopped && failure == nil {
+			a.Fatalf("Stopped after %s waiting for assertion to pass (tick never finished once): %s%s", elapsed, cause, details)
+		} else if outcome == pollingStopped {
+			a.Fatalf("%s\nStopped after %s waiting for assertion to pass: %s%s", failure, elapsed, cause, details)
+		} else if failure == nil {
+			a.Fatalf("Timed out after %s waiting for assertion to pass (tick never finished once)%s", duration, details)
+		} else if outcome == pollingExhausted {
+			a.Fatalf("%s\nGave up after %d attempts waiting for assertion to pass%s", failure, attempts, details)
+		} else {
+			a.Fatalf("%s\nTimed out after %s waiting for assertion to pass%s", failure, elapsed, details)
+		}
+	}
+}
+
+// describeDuration describes the given polling duration, where zero means polling until the context is done.
+func (a *assertion) describeDuration(duration time.Duration) string {
+	if duration > 0 {
+		return duration.String()
+	}
+	return "the lifetime of the context"
+}
+
+// tickResult is the outcome of a single attempt of a timed assertion.
+type tickResult struct {
+	failure   *internal.FormatAndArgs
+	succeeded bool
+}
+
+// tickState is the lifecycle state of a single attempt of a timed assertion.
+type tickState int
+
+const (
+	// tickAsserting means the attempt is running the matcher.
+	tickAsserting tickState = iota
+
+	// tickCleaningUp means the matcher returned, and the attempt is running its cleanups.
+	tickCleaningUp
+
+	// tickFinished means the attempt has finished and sent its result.
+	tickFinished
+)
+
+// tickT is the T given to the matcher during a single attempt of a timed assertion. Failures are contained as the
+// attempt's result instead of failing the test, and cleanups are performed at the end of the attempt instead of at the
+// end of the test. Each attempt has its own tickT, so an abandoned attempt that is still running cannot interfere with
+// the assertion or with later attempts.
+type tickT struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	parent    *assertion
+	mutex     sync.Mutex
+	state     tickState
+	abandoned bool
+	cleanups  []func()
+	done      chan tickResult
+}
+
+func newTickT(ctx context.Context, parent *assertion) *tickT {
+	ctx, cancel := context.WithCancel(ctx)
+	return &tickT{ctx: ctx, cancel: cancel, parent: parent, done: make(chan tickResult, 1)}
+}
+
+// run performs the attempt, and sends its result to the done channel once its cleanups have been performed.
+//
+//go:noinline
+func (t *tickT) run() {
+	GetHelper(t).Helper()
+
+	result := tickResult{}
+	defer func() { t.finish(result) }()
+
+	// Contain the potential "Fatal" calls from this attempt as its failure
+	defer func() {
+		if r := recover(); r != nil {
+			if fa, ok := r.(internal.FormatAndArgs); ok {
+				result.failure = &fa
+			} else if !t.Failed() {
+				panic(r)
+			}
+		} else {
+			result.succeeded = true
+		}
+	}()
+
+	// Perform cleanups for this attempt
+	defer t.cleanup()
+
+	t.parent.matcher.Assert(t, t.parent.actuals...)
+}
+
+//go:noinline
+func (t *tickT) cleanup() {
+	GetHelper(t).Helper()
+
+	t.mutex.Lock()
+	t.state = tickCleaningUp
+	cleanups := t.cleanups
+	t.cleanups = nil
+	t.mutex.Unlock()
+
+	// Like testing.T, the attempt context is canceled just before its cleanups are performed
+	t.cancel()
+
+	// TODO: decide what to do with failures during cleanups
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
+}
+
+func (t *tickT) finish(result tickResult) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.state = tickFinished
+	t.done <- result
+}
+
+// abandon marks the attempt as abandoned, and returns true if it had already finished asserting, which means its result
+// is (or will shortly be) available on the done channel.
+func (t *tickT) abandon() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.abandoned = true
+	t.cancel()
+	return t.state != tickAsserting
+}
+
+// isAbandoned returns true if the assertion stopped waiting for this attempt; logs of abandoned attempts are dropped,
+// since the test they belong to may have already completed.
+func (t *tickT) isAbandoned() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.abandoned
+}
+
+// Context returns the context of this attempt, which is canceled when the attempt is abandoned, or just before its
+// cleanups are performed.
+func (t *tickT) Context() context.Context {
+	return t.ctx
+}
+
+//go:noinline
+func (t *tickT) Name() string {
+	return t.parent.Name()
+}
+
+//go:noinline
+func (t *tickT) Cleanup(f func()) {
+	GetHelper(t).Helper()
+	t.mutex.Lock()
+	if t.state == tickAsserting {
+		t.cleanups = append(t.cleanups, f)
+		t.mutex.Unlock()
+	} else {
+		// Cleanups registered by cleanups themselves are performed immediately
+		t.mutex.Unlock()
+		f()
+	}
+}
+
+//go:noinline
+func (t *tickT) Failed() bool {
+	GetHelper(t).Helper()
+	return t.parent.Failed()
+}
+
+//go:noinline
+func (t *tickT) Fatalf(format string, args ...any) {
+	GetHelper(t).Helper()
+	panic(internal.FormatAndArgs{Format: &format, Args: args})
+}
+
+//go:noinline
+func (t *tickT) Log(args ...any) {
+	GetHelper(t).Helper()
+	if !t.isAbandoned() {
+		t.parent.Log(args...)
+	}
+}
+
+//go:noinline
+func (t *tickT) Logf(format string, args ...any) {
+	GetHelper(t).Helper()
+	if !t.isAbandoned() {
+		t.parent.Logf(format, args...)
+	}
+}
+
+//go:noinline
+func (t *tickT) GetParent() T {
+	return t.parent
+}
@@ -0,0 +1,233 @@
+package justest_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestPollingEngine(t *testing.T) {
+	t.Parallel()
+	t.Run("Cleanups are performed at the end of each attempt", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		var attempts, cleanups atomic.Int32
+		With(mt).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) {
+			if attempts.Add(1) != cleanups.Load()+1 {
+				t.Fatalf("cleanup of previous attempt was not performed")
+			}
+			t.Cleanup(func() { cleanups.Add(1) })
+		})).For(300*time.Millisecond, 10*time.Millisecond)
+		With(t).VerifyThat(cleanups.Load()).Will(EqualTo(attempts.Load())).Now()
+	})
+	t.Run("Attempt overlapping timeout is abandoned", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^Timed out after \d+ms waiting for assertion to pass \(tick never finished once\)\n.*`))
+		release := make(chan struct{})
+		finished := make(chan struct{})
+		defer func() { close(release); <-finished }()
+		With(mt).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) {
+			defer close(finished)
+			t.Cleanup(func() { t.Log("cleaning up") })
+			<-release
+			t.Logf("attempt released")
+			t.Fatalf("late failure")
+		})).Within(100*time.Millisecond, 10*time.Millisecond)
+	})
+	t.Run("Attempt cleaning up during timeout is awaited", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		cleanedUp := atomic.Bool{}
+		With(mt).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) {
+			t.Cleanup(func() {
+				time.Sleep(300 * time.Millisecond)
+				cleanedUp.Store(true)
+			})
+		})).Within(100*time.Millisecond, 10*time.Millisecond)
+		With(t).VerifyThat(cleanedUp.Load()).Will(EqualTo(true)).Now()
+	})
+	t.Run("Duration passing during an interval stops polling", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^failure\nTimed out after 1(\.\d+)?s waiting for assertion to pass\n.*`))
+		started := time.Now()
+		defer func() {
+			if elapsed := time.Since(started); elapsed > 1300*time.Millisecond {
+				t.Errorf("Expected polling to stop after 1s, but it took %s", elapsed)
+			}
+		}()
+		With(mt).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) { t.Fatalf("failure") })).
+			WithinUsing(time.Second, ExponentialBackoff(300*time.Millisecond, 4, 0))
+	})
+	t.Run("Interval longer than duration", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^Timed out after 100ms waiting for assertion to pass \(tick never finished once\)\n.*`))
+		started := time.Now()
+		defer func() {
+			if elapsed := time.Since(started); elapsed > time.Second {
+				t.Errorf("Expected polling to stop after 100ms, but it took %s", elapsed)
+			}
+		}()
+		With(mt).VerifyThat(1).Will(EqualTo(1)).For(100*time.Millisecond, time.Hour)
+	})
+	t.Run("Description is added once", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^Assertion that it works failed: failure\nTimed out after .*`))
+		With(mt).EnsureThat("it works").ByVerifying().Will(MatcherFunc(func(t T, actuals ...any) { t.Fatalf("failure") })).
+			Within(100*time.Millisecond, 10*time.Millisecond)
+	})
+}
+
+type deadlineT struct {
+	*MockT
+	deadline time.Time
+}
+
+func (t *deadlineT) Deadline() (time.Time, bool) { return t.deadline, true }
+
+func TestPollingWithContext(t *testing.T) {
+	t.Parallel()
+	t.Run("WithinContext fails when context is done", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^failure\nStopped after .+ waiting for assertion to pass: context deadline exceeded\n.*`))
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		With(mt).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) { t.Fatalf("failure") })).WithinContext(ctx, 10*time.Millisecond)
+	})
+	t.Run("WithinContext succeeds before context is done", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		With(mt).VerifyThat(1).Will(EqualTo(1)).WithinContext(ctx, 10*time.Millisecond)
+	})
+	t.Run("WithinContext stops during an interval longer than the context lifetime", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^Stopped after \d+(\.\d+)?ms waiting for assertion to pass \(tick never finished once\): context deadline exceeded\n.*`))
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		started := time.Now()
+		defer func() {
+			if elapsed := time.Since(started); elapsed > time.Second {
+				t.Errorf("Expected polling to stop when the context is done, but it took %s", elapsed)
+			}
+		}()
+		With(mt).VerifyThat(1).Will(EqualTo(1)).WithinContext(ctx, 3*time.Second)
+	})
+	t.Run("ForContext succeeds when context is done", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		With(mt).VerifyThat(1).Will(EqualTo(1)).ForContext(ctx, 10*time.Millisecond)
+	})
+	t.Run("ForContext fails on mismatch", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^failure\nAssertion failed after .+ and did not pass repeatedly for the lifetime of the context\n.*`))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		With(mt).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) { t.Fatalf("failure") })).ForContext(ctx, 10*time.Millisecond)
+	})
+	t.Run("Attempt context is canceled when attempt is abandoned", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^Timed out after .+ waiting for assertion to pass \(tick never finished once\)\n.*`))
+		canceled := make(chan struct{})
+		defer func() {
+			select {
+			case <-canceled:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("Attempt context was not canceled")
+			}
+		}()
+		With(mt).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) {
+			<-GetContext(t).Done()
+			close(canceled)
+		})).Within(100*time.Millisecond, 10*time.Millisecond)
+	})
+	t.Run("Attempt context is passed to functions", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		type key struct{}
+		ctx = context.WithValue(ctx, key{}, "value")
+		With(mt).VerifyThat(func(ctx context.Context, t T) string {
+			return ctx.Value(key{}).(string)
+		}).Will(Say("^value$")).WithinContext(ctx, 10*time.Millisecond)
+	})
+	t.Run("Polling stops before test deadline", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^failure\nStopped after .+ waiting for assertion to pass: test deadline is approaching\n.*`))
+		dt := &deadlineT{MockT: mt, deadline: time.Now().Add(TestDeadlineMargin + 200*time.Millisecond)}
+		With(dt).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) { t.Fatalf("failure") })).Within(time.Hour, 10*time.Millisecond)
+	})
+	t.Run("Polling stops before test deadline during an interval longer than the margin", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^Stopped after .+ waiting for assertion to pass \(tick never finished once\): test deadline is approaching\n.*`))
+		dt := &deadlineT{MockT: mt, deadline: time.Now().Add(TestDeadlineMargin + 200*time.Millisecond)}
+		started := time.Now()
+		defer func() {
+			if elapsed := time.Since(started); elapsed > time.Second {
+				t.Errorf("Expected polling to stop before the test deadline, but it took %s", elapsed)
+			}
+		}()
+		With(dt).VerifyThat(1).Will(EqualTo(1)).Within(time.Hour, 2*TestDeadlineMargin)
+	})
+}
+
+func TestSlowedBy(t *testing.T) {
+	t.Parallel()
+	t.Run("Duration and intervals are scaled", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^failure\nTimed out after 25s waiting for assertion to pass\nDuration and intervals were slowed by a factor of 2.5 \(10s became 25s\)\n.*`))
+		clock := NewFakeClock(time.Now())
+		var waits []time.Duration
+		last := clock.Now()
+		With(mt).UsingClock(clock).SlowedBy(2.5).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) {
+			waits = append(waits, clock.Now().Sub(last))
+			last = clock.Now()
+			t.Fatalf("failure")
+		})).Within(10*time.Second, 2*time.Second)
+		With(t).VerifyThat(waits).Will(EqualTo([]time.Duration{5 * time.Second, 5 * time.Second, 5 * time.Second, 5 * time.Second})).Now()
+	})
+	t.Run("Sub-second durations are preserved", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^Timed out after 750ms waiting for assertion to pass \(tick never finished once\)\nDuration and intervals were slowed by a factor of 1.5 \(500ms became 750ms\)\n.*`))
+		clock := NewFakeClock(time.Now())
+		With(mt).UsingClock(clock).SlowedBy(1.5).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) {})).
+			For(500*time.Millisecond, time.Second)
+	})
+	t.Run("Nested assertions inherit the slow factor", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`(?s)^Timed out after 4s waiting for assertion to pass \(tick never finished once\)\nDuration and intervals were slowed by a factor of 2 \(2s became 4s\)\n.*`))
+		clock := NewFakeClock(time.Now())
+		With(mt).UsingClock(clock).SlowedBy(2).VerifyThat(func(t T) {
+			With(t).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) {})).For(2*time.Second, 3*time.Second)
+		}).Will(Succeed()).Now()
+	})
+	t.Run("Invalid factor panics", func(t *testing.T) {
+		t.Parallel()
+		With(t).VerifyThat(func() { With(t).SlowedBy(0) }).Will(PanicWith(Say(`^expected a positive slow factor, got: 0$`))).Now()
+	})
+}
@@ -0,0 +1,95 @@
+package justest
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// softT is a T implementation that records failures instead of failing the test immediately. Each failure still aborts
+// the assertion that triggered it (by panicking with the softT itself), but the panic is contained by the assertion's
+// evaluation method (Now, For, Within) so that the next statement in the soft block is executed.
+type softT struct {
+	parent   T
+	failures []string
+	mutex    sync.Mutex
+}
+
+//go:noinline
+func (t *softT) Name() string {
+	return t.parent.Name()
+}
+
+//go:noinline
+func (t *softT) Cleanup(f func()) {
+	GetHelper(t).Helper()
+	t.parent.Cleanup(f)
+}
+
+//go:noinline
+func (t *softT) Failed() bool {
+	GetHelper(t).Helper()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return len(t.failures) > 0 || t.parent.Failed()
+}
+
+//go:noinline
+func (t *softT) Fatalf(format string, args ...any) {
+	GetHelper(t).Helper()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.failures = append(t.failures, fmt.Sprintf(format, args...))
+	panic(t)
+}
+
+//go:noinline
+func (t *softT) Log(args ...any) {
+	GetHelper(t).Helper()
+	t.parent.Log(args...)
+}
+
+//go:noinline
+func (t *softT) Logf(format string, args ...any) {
+	GetHelper(t).Helper()
+	t.parent.Logf(format, args...)
+}
+
+//go:noinline
+func (t *softT) GetParent() T {
+	return t.parent
+}
+
+// recoverFailure must be deferred directly by assertion evaluation methods; it swallows the panic raised by Fatalf so
+// that the soft block continues, while propagating any other panic.
+//
+//go:noinline
+func (t *softT) recoverFailure() {
+	if r := recover(); r != nil && r != t {
+		panic(r)
+	}
+}
+
+//go:noinline
+func (t *softT) report(desc string) {
+	GetHelper(t.parent).Helper()
+	t.mutex.Lock()
+	failures := t.failures
+	t.mutex.Unlock()
+
+	if len(failures) == 0 {
+		return
+	}
+
+	sb := strings.Builder{}
+	if desc != "" {
+		sb.WriteString(fmt.Sprintf("Assertion that %s failed: ", desc))
+	}
+	if len(failures) == 1 {
+		sb.WriteString("1 soft assertion failed:")
+	} else {
+		sb.WriteString(fmt.Sprintf("%d soft assertions failed:", len(failures)))
+	}
+	sb.WriteString(formatFailures(failures))
+	t.parent.Fatalf("%s", sb.String())
+}
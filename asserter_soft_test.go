@@ -0,0 +1,85 @@
+package justest_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestSoftly(t *testing.T) {
+	t.Parallel()
+	t.Run("Succeeds when no assertion fails", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		With(mt).Softly(func(t T) {
+			With(t).VerifyThat(1).Will(EqualTo(1)).Now()
+			With(t).VerifyThat("abc").Will(Say("^abc$")).Now()
+		})
+	})
+	t.Run("Single failure is reported", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`(?s)^1 soft assertion failed:\n\[1\] Unexpected difference.*-.+2,\n.*\+.+1,.*asserter_soft_test\.go:\d+ --> With\(t\)\.VerifyThat\(1\)\.Will\(EqualTo\(2\)\)\.Now\(\)$`))
+		With(mt).Softly(func(t T) {
+			With(t).VerifyThat(1).Will(EqualTo(2)).Now()
+			With(t).VerifyThat(3).Will(EqualTo(3)).Now()
+		})
+	})
+	t.Run("All failures are reported", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`(?s)^3 soft assertions failed:\n\[1\] .*-.+2,.*\n\[2\] .*-.+4,.*\n\[3\] Expected actual value 5 to be greater than 6.*asserter_soft_test\.go:\d+.*`))
+		With(mt).Softly(func(t T) {
+			With(t).VerifyThat(1).Will(EqualTo(2)).Now()
+			With(t).VerifyThat(3).Will(EqualTo(4)).Now()
+			With(t).VerifyThat(5).Will(EqualTo(5)).Now()
+			With(t).VerifyThat(5).Will(BeGreaterThan(6)).Now()
+		})
+	})
+	t.Run("Description is propagated", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`(?s)^Assertion that all fields are valid failed: 1 soft assertion failed:.*`))
+		With(mt).EnsureThat("all fields are valid").Softly(func(t T) {
+			With(t).VerifyThat(1).Will(EqualTo(2)).Now()
+		})
+	})
+	t.Run("Timed assertions are recorded", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`(?s)^1 soft assertion failed:\n\[1\] failure\n\s+Timed out after .*`))
+		executed := false
+		With(mt).Softly(func(t T) {
+			With(t).VerifyThat(1).Will(MatcherFunc(func(t T, actuals ...any) { t.Fatalf("failure") })).Within(300*time.Millisecond, 50*time.Millisecond)
+			executed = true
+		})
+		if !executed {
+			t.Fatalf("Soft block was aborted by a failed assertion")
+		}
+	})
+	t.Run("Direct failure stops the block", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`(?s)^2 soft assertions failed:\n\[1\] .*\n\[2\] direct failure$`))
+		executed := false
+		With(mt).Softly(func(t T) {
+			With(t).VerifyThat(1).Will(EqualTo(2)).Now()
+			t.Fatalf("direct failure")
+			executed = true
+		})
+		if executed {
+			t.Fatalf("Soft block continued after a direct failure")
+		}
+	})
+	t.Run("Panics are propagated", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(PanicVerifier(`unexpected panic`))
+		With(mt).Softly(func(t T) {
+			With(t).VerifyThat(1).Will(MatcherFunc(func(t T, actuals ...any) { panic("unexpected panic") })).Now()
+		})
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 func TestWith(t *testing.T) {
@@ -0,0 +1,147 @@
+package justest
+
+import (
+	"errors"
+	"io"
+	"regexp"
+	"sync"
+)
+
+var (
+	// readerBuffers holds the buffers into which io.Reader actuals of text matchers (e.g. Say) are drained, so that
+	// successive Say assertions on the same reader continue from where the previous match ended.
+	readerBuffers sync.Map
+)
+
+// Buffer is a thread-safe io.Writer (e.g. for the output of a running process) that remembers a read cursor. When
+// given to the Say matcher, only the content after the cursor is matched, and a successful match advances the cursor
+// to the end of the matched text; successive Say assertions (e.g. with Within) therefore match content in order.
+//
+// Buffer is also an io.Reader, which reads the content after the cursor and advances it.
+type Buffer struct {
+	mu       sync.Mutex
+	contents []byte
+	cursor   int
+	closed   bool
+}
+
+// NewBuffer returns a new, empty Buffer.
+//
+//go:noinline
+func NewBuffer() *Buffer {
+	return &Buffer{}
+}
+
+// BufferWithBytes returns a new Buffer containing the given bytes.
+//
+//go:noinline
+func BufferWithBytes(data []byte) *Buffer {
+	return &Buffer{contents: append([]byte(nil), data...)}
+}
+
+// BufferReader returns a new Buffer into which the given reader is drained in the background. The buffer is closed
+// once the reader is exhausted (or fails).
+//
+//go:noinline
+func BufferReader(r io.Reader) *Buffer {
+	b := NewBuffer()
+	go b.drain(r)
+	return b
+}
+
+// Write appends the given data to the buffer; it fails if the buffer is closed.
+func (b *Buffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return 0, errors.New("attempt to write to a closed buffer")
+	}
+	b.contents = append(b.contents, p...)
+	return len(p), nil
+}
+
+// Read reads the content after the read cursor, advancing it; it returns io.EOF if there is no such content.
+func (b *Buffer) Read(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.cursor >= len(b.contents) {
+		return 0, io.EOF
+	}
+	n := copy(p, b.contents[b.cursor:])
+	b.cursor += n
+	return n, nil
+}
+
+// Close closes the buffer, so that further writes fail.
+func (b *Buffer) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	return nil
+}
+
+// Closed returns true if the buffer was closed.
+func (b *Buffer) Closed() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.closed
+}
+
+// Contents returns a copy of the entire content of the buffer, regardless of the read cursor.
+func (b *Buffer) Contents() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]byte(nil), b.contents...)
+}
+
+// String returns the entire content of the buffer, regardless of the read cursor.
+func (b *Buffer) String() string {
+	return string(b.Contents())
+}
+
+// say matches the given regular expression against the content after the read cursor, advancing the cursor to the end
+// of the match if found; the unread content is returned if no match is found.
+func (b *Buffer) say(re *regexp.Regexp) (bool, string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	unread := b.contents[b.cursor:]
+	if loc := re.FindIndex(unread); loc != nil {
+		b.cursor += loc[1]
+		return true, ""
+	}
+	return false, string(unread)
+}
+
+// drain copies the given reader into the buffer until it is exhausted (or fails), and then closes the buffer.
+func (b *Buffer) drain(r io.Reader) {
+	_, _ = io.Copy(b, r)
+	_ = b.Close()
+}
+
+// readerBufferEntry is the Buffer of a reader in readerBuffers, which is initialized once.
+type readerBufferEntry struct {
+	once   sync.Once
+	buffer *Buffer
+}
+
+// readerBuffer returns the Buffer into which the given reader is drained, creating it on first use. In-memory readers
+// (e.g. strings.Reader, which report their unread length) never block, and are read right away; other readers (e.g.
+// pipes) may block until content arrives, so they are drained in the background, and only the content read so far is
+// in the buffer. The buffer is forgotten once the (root) test ends.
+func readerBuffer(t T, r io.Reader) *Buffer {
+	GetHelper(t).Helper()
+	v, loaded := readerBuffers.LoadOrStore(r, &readerBufferEntry{})
+	entry := v.(*readerBufferEntry)
+	if !loaded {
+		GetRoot(t).Cleanup(func() { readerBuffers.Delete(r) })
+	}
+	entry.once.Do(func() {
+		entry.buffer = NewBuffer()
+		if _, ok := r.(interface{ Len() int }); ok {
+			entry.buffer.drain(r)
+		} else {
+			go entry.buffer.drain(r)
+		}
+	})
+	return entry.buffer
+}
@@ -0,0 +1,42 @@
+package justest_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/arikkfir/justest"
+)
+
+func TestBuffer(t *testing.T) {
+	t.Parallel()
+	t.Run("Read advances the cursor", func(t *testing.T) {
+		t.Parallel()
+		b := BufferWithBytes([]byte("hello"))
+		p := make([]byte, 3)
+		With(t).VerifyThat(b.Read(p)).Will(EqualTo(3, nil)).Now()
+		With(t).VerifyThat(string(p)).Will(EqualTo("hel")).Now()
+		With(t).VerifyThat(io.ReadAll(b)).Will(EqualTo([]byte("lo"), nil)).Now()
+		With(t).VerifyThat(b.Read(p)).Will(FailWith(io.EOF)).Now()
+		With(t).VerifyThat(b.String()).Will(EqualTo("hello")).Now()
+	})
+	t.Run("Writes fail once closed", func(t *testing.T) {
+		t.Parallel()
+		b := NewBuffer()
+		With(t).VerifyThat(b.Write([]byte("abc"))).Will(EqualTo(3, nil)).Now()
+		With(t).VerifyThat(b.Closed()).Will(EqualTo(false)).Now()
+		With(t).VerifyThat(b.Close()).Will(Succeed()).Now()
+		With(t).VerifyThat(b.Closed()).Will(EqualTo(true)).Now()
+		With(t).VerifyThat(b.Write([]byte("def"))).Will(Fail(`attempt to write to a closed buffer`)).Now()
+		With(t).VerifyThat(b.Contents()).Will(EqualTo([]byte("abc"))).Now()
+	})
+	t.Run("Reader is drained and closed", func(t *testing.T) {
+		t.Parallel()
+		b := BufferReader(strings.NewReader("drained"))
+		With(t).VerifyThat(func(t T) {
+			With(t).VerifyThat(b.Closed()).Will(EqualTo(true)).Now()
+		}).Will(Succeed()).Within(time.Second, 10*time.Millisecond)
+		With(t).VerifyThat(b.String()).Will(EqualTo("drained")).Now()
+	})
+}
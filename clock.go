@@ -0,0 +1,149 @@
+package justest
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time used by timed assertions (For and Within) for their deadlines and polling intervals.
+// Assertions use the real clock by default; a different clock can be provided with VerifyOrEnsure.UsingClock.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer creates a Timer that sends the current time on its channel once the given duration has passed.
+	NewTimer(d time.Duration) Timer
+
+	// Sleep pauses the current goroutine for the given duration.
+	Sleep(d time.Duration)
+}
+
+// Timer is a single event that fires once a duration has passed on its Clock.
+type Timer interface {
+	// C returns the channel on which the time is delivered once the timer fires.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing, returning false if it already fired or was already stopped.
+	Stop() bool
+}
+
+var (
+	// RealClock is a Clock backed by the "time" package.
+	RealClock Clock = &realClock{}
+)
+
+type realClock struct{}
+
+func (c *realClock) Now() time.Time { return time.Now() }
+
+func (c *realClock) NewTimer(d time.Duration) Timer { return &realTimer{timer: time.NewTimer(d)} }
+
+func (c *realClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+type realTimer struct {
+	timer *time.Timer
+}
+
+func (t *realTimer) C() <-chan time.Time { return t.timer.C }
+
+func (t *realTimer) Stop() bool { return t.timer.Stop() }
+
+// FakeClock is a Clock whose time only moves when it is advanced, either explicitly via Advance, or by sleeping on it.
+// Timed assertions using a FakeClock advance it to each of their attempts, which makes long assertions such as
+// "For(30*time.Second, time.Second)" complete immediately. Code under test that uses the same clock observes the same
+// virtual time, and its own calls to Sleep advance the clock for the assertion as well.
+type FakeClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+// NewFakeClock creates a FakeClock whose current time is the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	timer := &fakeTimer{clock: c, deadline: c.now.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		timer.c <- c.now
+	} else {
+		c.timers = append(c.timers, timer)
+	}
+	return timer
+}
+
+// Sleep advances the clock by the given duration, without blocking.
+func (c *FakeClock) Sleep(d time.Duration) {
+	c.Advance(d)
+}
+
+// Advance moves the clock forward by the given duration, firing any timers whose deadline has been reached, in order
+// of their deadlines.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(d)
+
+	sort.SliceStable(c.timers, func(i, j int) bool { return c.timers[i].deadline.Before(c.timers[j].deadline) })
+	pending := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.deadline.After(c.now) {
+			pending = append(pending, timer)
+		} else {
+			timer.c <- timer.deadline
+		}
+	}
+	c.timers = pending
+}
+
+// removeTimer removes the given timer from the clock, returning whether it was pending.
+func (c *FakeClock) removeTimer(timer *fakeTimer) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for i, candidate := range c.timers {
+		if candidate == timer {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+type fakeTimer struct {
+	clock    *FakeClock
+	deadline time.Time
+	c        chan time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.c }
+
+func (t *fakeTimer) Stop() bool { return t.clock.removeTimer(t) }
+
+// nearestAssertion returns the nearest assertion in the given T's ancestry, if any; assertions nested inside a timed
+// assertion inherit its clock and slow factor.
+//
+//go:noinline
+func nearestAssertion(t T) *assertion {
+	for t != nil {
+		if a, ok := t.(*assertion); ok {
+			return a
+		}
+		if hp, ok := t.(HasParent); ok {
+			t = hp.GetParent()
+		} else {
+			break
+		}
+	}
+	return nil
+}
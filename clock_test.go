@@ -0,0 +1,111 @@
+package justest_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestFakeClock(t *testing.T) {
+	t.Parallel()
+	t.Run("Time only moves when advanced", func(t *testing.T) {
+		t.Parallel()
+		start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		clock := NewFakeClock(start)
+		if now := clock.Now(); !now.Equal(start) {
+			t.Fatalf("Expected %s, got %s", start, now)
+		}
+		clock.Advance(time.Minute)
+		if now := clock.Now(); !now.Equal(start.Add(time.Minute)) {
+			t.Fatalf("Expected %s, got %s", start.Add(time.Minute), now)
+		}
+		clock.Sleep(time.Second)
+		if now := clock.Now(); !now.Equal(start.Add(time.Minute + time.Second)) {
+			t.Fatalf("Expected %s, got %s", start.Add(time.Minute+time.Second), now)
+		}
+	})
+	t.Run("Timers fire when their deadline is reached", func(t *testing.T) {
+		t.Parallel()
+		clock := NewFakeClock(time.Now())
+		timer := clock.NewTimer(10 * time.Second)
+		clock.Advance(9 * time.Second)
+		select {
+		case <-timer.C():
+			t.Fatalf("Timer fired before its deadline")
+		default:
+		}
+		clock.Advance(1 * time.Second)
+		select {
+		case <-timer.C():
+		default:
+			t.Fatalf("Timer did not fire on its deadline")
+		}
+		if timer.Stop() {
+			t.Fatalf("Stopping a fired timer should return false")
+		}
+	})
+	t.Run("Stopped timers do not fire", func(t *testing.T) {
+		t.Parallel()
+		clock := NewFakeClock(time.Now())
+		timer := clock.NewTimer(10 * time.Second)
+		if !timer.Stop() {
+			t.Fatalf("Stopping a pending timer should return true")
+		}
+		clock.Advance(time.Minute)
+		select {
+		case <-timer.C():
+			t.Fatalf("Stopped timer fired")
+		default:
+		}
+	})
+}
+
+func TestUsingClock(t *testing.T) {
+	t.Parallel()
+	t.Run("For completes without waiting for real time", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		clock := NewFakeClock(time.Now())
+		started := time.Now()
+		With(mt).UsingClock(clock).VerifyThat(1).Will(EqualTo(1)).For(30*time.Second, time.Second)
+		if elapsed := time.Since(started); elapsed > 5*time.Second {
+			t.Fatalf("Assertion took %s of real time", elapsed)
+		}
+	})
+	t.Run("Within observes the clock shared with code under test", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		clock := NewFakeClock(time.Now())
+		readyAt := clock.Now().Add(10 * time.Second)
+		ready := MatcherFunc(func(t T, actuals ...any) {
+			if clock.Now().Before(readyAt) {
+				t.Fatalf("not ready yet")
+			}
+		})
+		With(mt).UsingClock(clock).VerifyThat().Will(ready).Within(time.Minute, time.Second)
+	})
+	t.Run("Within times out on the fake clock", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^not yet\nTimed out after 5s waiting for assertion to pass\n.*`))
+		clock := NewFakeClock(time.Now())
+		With(mt).UsingClock(clock).VerifyThat(1).Will(MatcherFunc(func(t T, actuals ...any) { t.Fatalf("not yet") })).Within(5*time.Second, time.Second)
+	})
+	t.Run("Nested assertions inherit the clock", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		clock := NewFakeClock(time.Now())
+		started := time.Now()
+		With(mt).UsingClock(clock).VerifyThat(func(t T) {
+			With(t).VerifyThat(1).Will(EqualTo(1)).For(10*time.Second, time.Second)
+		}).Will(Succeed()).Within(time.Minute, time.Second)
+		if elapsed := time.Since(started); elapsed > 5*time.Second {
+			t.Fatalf("Nested assertion took %s of real time", elapsed)
+		}
+	})
+}
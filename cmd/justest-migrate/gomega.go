@@ -0,0 +1,328 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// gomegaRoots are the Gomega functions (and Gomega instance methods) that start an assertion.
+var gomegaRoots = map[string]bool{
+	"Expect": true, "Ω": true, "ExpectWithOffset": true,
+	"Eventually": true, "EventuallyWithOffset": true,
+	"Consistently": true, "ConsistentlyWithOffset": true,
+}
+
+// gomegaAssertion converts the given call if it is a Gomega assertion, returning the justest assertion or the reason
+// why it cannot be converted; the last return value is false if the call is not a Gomega assertion at all.
+func (m *migrator) gomegaAssertion(call *ast.CallExpr) (string, string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+
+	// Find the root of the assertion (e.g. "Eventually(...)"), and the modifiers applied to it (e.g. "WithTimeout(...)")
+	var root *ast.CallExpr
+	var rootName, t string
+	var modifiers []*ast.CallExpr
+	for expr := sel.X; root == nil; {
+		c, ok := expr.(*ast.CallExpr)
+		if !ok {
+			return "", "", false
+		} else if rootName, t, ok = m.gomegaRoot(c.Fun); ok {
+			root = c
+		} else if s, ok := c.Fun.(*ast.SelectorExpr); ok {
+			modifiers = append(modifiers, c)
+			expr = s.X
+		} else {
+			return "", "", false
+		}
+	}
+	if t == "" {
+		return "", "could not determine which *testing.T to use", true
+	}
+
+	var negated bool
+	switch sel.Sel.Name {
+	case "To", "Should":
+		negated = false
+	case "NotTo", "ToNot", "ShouldNot":
+		negated = true
+	default:
+		return "", fmt.Sprintf("Gomega's %s has no automatic justest equivalent", sel.Sel.Name), true
+	}
+	if len(call.Args) == 0 {
+		return "", fmt.Sprintf("unexpected call to Gomega's %s without a matcher", sel.Sel.Name), true
+	}
+	matcher, reason := m.gomegaMatcher(call.Args[0])
+	if reason != "" {
+		return "", reason, true
+	} else if negated {
+		if name, ok := m.gomegaMatcherName(call.Args[0]); ok && name == "HaveOccurred" {
+			matcher = m.call("Succeed")
+		} else {
+			matcher = m.not(matcher)
+		}
+	}
+	description := call.Args[1:]
+	if len(description) > 0 && !isStringExpr(description[0]) {
+		return "", "description is not a string literal", true
+	}
+
+	args := root.Args
+	if strings.HasSuffix(rootName, "WithOffset") {
+		if len(args) == 0 {
+			return "", fmt.Sprintf("unexpected call to Gomega's %s without arguments", rootName), true
+		}
+		rootName, args = strings.TrimSuffix(rootName, "WithOffset"), args[1:]
+	}
+	if len(args) == 0 {
+		return "", fmt.Sprintf("unexpected call to Gomega's %s without arguments", rootName), true
+	}
+
+	switch rootName {
+	case "Expect", "Ω":
+		if len(args) > 1 {
+			return "", "assertions on multiple values have no automatic justest equivalent", true
+		}
+		for _, modifier := range modifiers {
+			if name := modifier.Fun.(*ast.SelectorExpr).Sel.Name; name != "WithOffset" {
+				return "", fmt.Sprintf("Gomega's %s modifier has no automatic justest equivalent", name), true
+			}
+		}
+		return m.assertion(t, description, m.textOf(args[0]), matcher, "Now()"), "", true
+
+	default:
+		var timeout, polling ast.Expr
+		if len(args) > 3 {
+			return "", fmt.Sprintf("unexpected number of arguments for Gomega's %s", rootName), true
+		} else if len(args) > 1 {
+			timeout = args[1]
+			if len(args) > 2 {
+				polling = args[2]
+			}
+		}
+		for _, modifier := range modifiers {
+			name := modifier.Fun.(*ast.SelectorExpr).Sel.Name
+			switch {
+			case name == "WithOffset":
+			case (name == "WithTimeout" || name == "Within") && len(modifier.Args) == 1:
+				timeout = modifier.Args[0]
+			case (name == "WithPolling" || name == "ProbeEvery") && len(modifier.Args) == 1:
+				polling = modifier.Args[0]
+			default:
+				return "", fmt.Sprintf("Gomega's %s modifier has no automatic justest equivalent", name), true
+			}
+		}
+
+		var f string
+		switch actual := args[0].(type) {
+		case *ast.FuncLit:
+			if actual.Type.Params.NumFields() > 0 {
+				return "", "polled functions with arguments have no automatic justest equivalent", true
+			}
+			f = m.textOf(actual)
+		case *ast.Ident, *ast.SelectorExpr:
+			f = m.textOf(actual)
+		default:
+			return "", "polled value is not a function", true
+		}
+
+		var timeoutText, pollingText string
+		if timeout == nil {
+			timeoutText = m.formatDuration(time.Second)
+			if rootName == "Consistently" {
+				timeoutText = m.formatDuration(100 * time.Millisecond)
+			}
+		} else if timeoutText, ok = m.duration(timeout); !ok {
+			return "", "could not parse duration " + m.textOf(timeout), true
+		}
+		if polling == nil {
+			pollingText = m.formatDuration(10 * time.Millisecond)
+		} else if pollingText, ok = m.duration(polling); !ok {
+			return "", "could not parse duration " + m.textOf(polling), true
+		}
+		evaluation := "Within"
+		if rootName == "Consistently" {
+			evaluation = "For"
+		}
+		actual := m.pollFunc(f, matcher)
+		return m.assertion(t, description, actual, m.call("Succeed"), evaluation+"("+timeoutText+", "+pollingText+")"), "", true
+	}
+}
+
+// gomegaRoot returns the name of the given Gomega function (or Gomega instance method) if it starts an assertion, as
+// well as the text of the T to use for the justest assertion (empty if it could not be determined).
+func (m *migrator) gomegaRoot(fun ast.Expr) (string, string, bool) {
+	if name, ok := m.gomegaRef(fun); ok && gomegaRoots[name] {
+		return name, m.t(), true
+	} else if sel, ok := fun.(*ast.SelectorExpr); ok && gomegaRoots[sel.Sel.Name] {
+		if ident, ok := sel.X.(*ast.Ident); ok {
+			if t, ok := m.gomegaInstance(ident); ok {
+				return sel.Sel.Name, t, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// gomegaRef returns the name of the Gomega package member the given expression refers to, if it does.
+func (m *migrator) gomegaRef(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if m.gomega == "." && e.Obj == nil && ast.IsExported(e.Name) {
+			return e.Name, true
+		}
+	case *ast.SelectorExpr:
+		if ident, ok := e.X.(*ast.Ident); ok && m.gomega != "" && m.gomega != "." && ident.Obj == nil && ident.Name == m.gomega {
+			return e.Sel.Name, true
+		}
+	}
+	return "", false
+}
+
+// gomegaMatcherName returns the name of the Gomega matcher the given expression creates, if it does.
+func (m *migrator) gomegaMatcherName(expr ast.Expr) (string, bool) {
+	if call, ok := expr.(*ast.CallExpr); ok {
+		return m.gomegaRef(call.Fun)
+	}
+	return "", false
+}
+
+// gomegaValueOrMatcher returns the text of the given expression, converting it if it is a Gomega matcher.
+func (m *migrator) gomegaValueOrMatcher(expr ast.Expr) (string, string) {
+	if _, ok := m.gomegaMatcherName(expr); ok {
+		return m.gomegaMatcher(expr)
+	}
+	return m.textOf(expr), ""
+}
+
+// gomegaValuesOrMatchers returns the comma-separated texts of the given expressions, converting Gomega matchers.
+func (m *migrator) gomegaValuesOrMatchers(exprs []ast.Expr) ([]string, string) {
+	texts := make([]string, len(exprs))
+	for i, expr := range exprs {
+		text, reason := m.gomegaValueOrMatcher(expr)
+		if reason != "" {
+			return nil, reason
+		}
+		texts[i] = text
+	}
+	return texts, ""
+}
+
+// gomegaMatcher returns the text of the justest equivalent of the given Gomega matcher, or the reason why it has none.
+func (m *migrator) gomegaMatcher(expr ast.Expr) (string, string) {
+	name, ok := m.gomegaMatcherName(expr)
+	if !ok {
+		return "", "unsupported matcher " + m.textOf(expr)
+	}
+	args := expr.(*ast.CallExpr).Args
+	unsupported := fmt.Sprintf("Gomega's %s matcher has no automatic justest equivalent", name)
+
+	switch {
+	case len(args) == 0 && (name == "BeNil" || name == "BeEmpty" || name == "Succeed" || name == "Panic"):
+		return m.call(name), ""
+	case len(args) == 0 && name == "BeTrue":
+		return m.call("EqualTo", "true"), ""
+	case len(args) == 0 && name == "BeFalse":
+		return m.call("EqualTo", "false"), ""
+	case len(args) == 0 && name == "HaveOccurred":
+		return m.call("Fail"), ""
+	case len(args) == 1 && (name == "Equal" || name == "HaveLen"):
+		if name == "Equal" {
+			name = "EqualTo"
+		}
+		return m.call(name, m.textOf(args[0])), ""
+	case len(args) == 1 && name == "MatchRegexp":
+		return m.call("Say", m.textOf(args[0])), ""
+	case len(args) == 1 && (name == "ContainSubstring" || name == "HavePrefix" || name == "HaveSuffix"):
+		prefix, suffix := "", ""
+		if name == "HavePrefix" {
+			prefix = "^"
+		} else if name == "HaveSuffix" {
+			suffix = "$"
+		}
+		if pattern, ok := regexpLiteral(args[0], prefix, suffix); ok {
+			return m.call("Say", pattern), ""
+		}
+		return "", fmt.Sprintf("argument of Gomega's %s matcher is not a string literal", name)
+	case len(args) == 1 && name == "MatchError":
+		if pattern, ok := regexpLiteral(args[0], "^", "$"); ok {
+			return m.call("MatchError", pattern), ""
+		}
+		target, reason := m.gomegaValueOrMatcher(args[0])
+		if reason != "" {
+			return "", reason
+		}
+		return m.call("MatchError", target), ""
+	case len(args) == 1 && (name == "ContainElement" || name == "HaveKey" || name == "Not"):
+		if name == "Not" {
+			if _, ok := m.gomegaMatcherName(args[0]); !ok {
+				return "", "unsupported matcher " + m.textOf(args[0])
+			}
+		}
+		arg, reason := m.gomegaValueOrMatcher(args[0])
+		if reason != "" {
+			return "", reason
+		}
+		return m.call(name, arg), ""
+	case len(args) == 1 && name == "PanicWith":
+		if _, ok := m.gomegaMatcherName(args[0]); !ok {
+			return m.call(name, m.call("EqualTo", m.textOf(args[0]))), ""
+		}
+		arg, reason := m.gomegaMatcher(args[0])
+		if reason != "" {
+			return "", reason
+		}
+		return m.call(name, arg), ""
+	case len(args) == 2 && name == "HaveKeyWithValue",
+		len(args) > 0 && (name == "ContainElements" || name == "ConsistOf"):
+		texts, reason := m.gomegaValuesOrMatchers(args)
+		if reason != "" {
+			return "", reason
+		}
+		return m.call(name, texts...), ""
+	case len(args) > 0 && (name == "And" || name == "SatisfyAll" || name == "Or" || name == "SatisfyAny"):
+		for _, arg := range args {
+			if _, ok := m.gomegaMatcherName(arg); !ok {
+				return "", "unsupported matcher " + m.textOf(arg)
+			}
+		}
+		texts, reason := m.gomegaValuesOrMatchers(args)
+		if reason != "" {
+			return "", reason
+		} else if name == "And" || name == "SatisfyAll" {
+			return m.call("AllOf", texts...), ""
+		}
+		return m.call("AnyOf", texts...), ""
+	case name == "BeNumerically" && (len(args) == 2 || len(args) == 3):
+		lit, ok := args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return "", "comparator of Gomega's BeNumerically matcher is not a string literal"
+		}
+		comparator, _ := strconv.Unquote(lit.Value)
+		value := m.textOf(args[1])
+		switch {
+		case comparator == "~" && len(args) == 3:
+			return m.call("BeCloseTo", value, m.textOf(args[2])), ""
+		case comparator == "~":
+			return m.call("BeCloseTo", value, "1e-8"), ""
+		case len(args) == 3:
+			return "", unsupported
+		case comparator == "==":
+			return m.call("BeBetween", value, value), ""
+		case comparator == ">":
+			return m.call("BeGreaterThan", value), ""
+		case comparator == ">=":
+			return m.not(m.call("BeLessThan", value)), ""
+		case comparator == "<":
+			return m.call("BeLessThan", value), ""
+		case comparator == "<=":
+			return m.not(m.call("BeGreaterThan", value)), ""
+		}
+	}
+	return "", unsupported
+}
@@ -0,0 +1,118 @@
+// Command justest-migrate rewrites tests written with testify (assert & require) and Gomega to use justest.
+//
+// Usage:
+//
+//	justest-migrate [-diff] [path ...]
+//
+// Each path may be a Go file or a directory, which is scanned recursively for "_test.go" files (the "vendor" and
+// "testdata" directories, as well as hidden directories, are skipped); a trailing "/..." is accepted for familiarity.
+// If no paths are given, the current directory is used. Files are rewritten in place, unless "-diff" is given, in
+// which case a unified diff of the changes is printed instead, and no file is modified.
+//
+// Assertions that cannot be converted automatically are left in place, preceded by a "TODO(justest-migrate)" comment
+// explaining why. Note that testify's "assert" functions do not stop the test when they fail, whereas justest
+// assertions always do (like testify's "require" functions).
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/arikkfir/justest/internal"
+)
+
+func main() {
+	diff := flag.Bool("diff", false, "print a unified diff of the changes instead of rewriting files")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-diff] [path ...]\n", filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	failed := false
+	for _, path := range paths {
+		files, err := findFiles(path)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			failed = true
+			continue
+		}
+		for _, file := range files {
+			if err := migrateFile(file, *diff); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "%s: %v\n", file, err)
+				failed = true
+			}
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// findFiles returns the given path if it is a file, or all test files under it if it is a directory.
+func findFiles(path string) ([]string, error) {
+	if path == "..." || strings.HasSuffix(path, "/...") {
+		path = strings.TrimSuffix(strings.TrimSuffix(path, "..."), "/")
+		if path == "" {
+			path = "."
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	} else if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		} else if d.IsDir() {
+			name := d.Name()
+			if p != path && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+		} else if strings.HasSuffix(p, "_test.go") {
+			files = append(files, p)
+		}
+		return nil
+	})
+	return files, err
+}
+
+// migrateFile migrates the given file, either rewriting it in place, or printing the diff of the migration.
+func migrateFile(filename string, diff bool) error {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	migrated, err := Migrate(filename, src)
+	if err != nil {
+		return err
+	} else if string(migrated) == string(src) {
+		return nil
+	}
+
+	if diff {
+		fmt.Print(internal.UnifiedDiff(filename, filename, string(src), string(migrated), 3))
+		return nil
+	}
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, migrated, info.Mode())
+}
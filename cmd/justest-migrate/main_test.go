@@ -0,0 +1,113 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	"github.com/arikkfir/justest/internal"
+)
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+	inputs, err := filepath.Glob("testdata/*.go.in")
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(inputs).Will(Not(BeEmpty())).Now()
+
+	for _, input := range inputs {
+		input := input
+		name := strings.TrimSuffix(filepath.Base(input), ".go.in")
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			src, err := os.ReadFile(input)
+			With(t).VerifyThat(err).Will(Succeed()).Now()
+			golden, err := os.ReadFile(strings.TrimSuffix(input, ".in") + ".golden")
+			With(t).VerifyThat(err).Will(Succeed()).Now()
+
+			migrated, err := Migrate(input, src)
+			With(t).VerifyThat(err).Will(Succeed()).Now()
+			With(t).VerifyThat(string(migrated)).Will(EqualTo(string(golden))).Now()
+
+			// Migrating an already-migrated file must not change it
+			again, err := Migrate(input, migrated)
+			With(t).VerifyThat(err).Will(Succeed()).Now()
+			With(t).VerifyThat(string(again)).Will(EqualTo(string(migrated))).Now()
+		})
+	}
+}
+
+func TestMigrateIgnoresOtherFiles(t *testing.T) {
+	t.Parallel()
+	src := []byte("package sample_test\n\nimport \"testing\"\n\nfunc TestSomething(t *testing.T) {}\n")
+	migrated, err := Migrate("sample_test.go", src)
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(string(migrated)).Will(EqualTo(string(src))).Now()
+}
+
+func TestMigrateInvalidSource(t *testing.T) {
+	t.Parallel()
+	_, err := Migrate("sample_test.go", []byte("package sample_test\n\nfunc {"))
+	With(t).VerifyThat(err).Will(Fail()).Now()
+}
+
+func TestFindFiles(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	for _, name := range []string{"a_test.go", "a.go", "sub/b_test.go", "testdata/c_test.go", "vendor/d_test.go", ".hidden/e_test.go"} {
+		path := filepath.Join(dir, name)
+		With(t).VerifyThat(os.MkdirAll(filepath.Dir(path), 0755)).Will(Succeed()).Now()
+		With(t).VerifyThat(os.WriteFile(path, nil, 0644)).Will(Succeed()).Now()
+	}
+
+	files, err := findFiles(dir)
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(files).Will(EqualTo([]string{filepath.Join(dir, "a_test.go"), filepath.Join(dir, "sub", "b_test.go")})).Now()
+
+	files, err = findFiles(dir + "/...")
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(files).Will(HaveLen(2)).Now()
+
+	files, err = findFiles(filepath.Join(dir, "a.go"))
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(files).Will(EqualTo([]string{filepath.Join(dir, "a.go")})).Now()
+}
+
+func TestMigrateFile(t *testing.T) {
+	t.Parallel()
+	src, err := os.ReadFile("testdata/testify.go.in")
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	golden, err := os.ReadFile("testdata/testify.go.golden")
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+
+	path := filepath.Join(t.TempDir(), "sample_test.go")
+	With(t).VerifyThat(os.WriteFile(path, src, 0600)).Will(Succeed()).Now()
+	With(t).VerifyThat(migrateFile(path, false)).Will(Succeed()).Now()
+
+	migrated, err := os.ReadFile(path)
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(string(migrated)).Will(EqualTo(string(golden))).Now()
+	info, err := os.Stat(path)
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(info.Mode().Perm()).Will(EqualTo(os.FileMode(0600))).Now()
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Parallel()
+	from := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n"
+	to := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\n"
+	With(t).VerifyThat(internal.UnifiedDiff("x", "y", from, from, 3)).Will(BeEmpty()).Now()
+	With(t).VerifyThat(internal.UnifiedDiff("x", "y", from, to, 1)).Will(EqualTo("" +
+		"--- x\n" +
+		"+++ y\n" +
+		"@@ -1,3 +1,3 @@\n" +
+		" a\n" +
+		"-b\n" +
+		"+B\n" +
+		" c\n" +
+		"@@ -10 +10,2 @@\n" +
+		" j\n" +
+		"+k\n",
+	)).Now()
+}
@@ -0,0 +1,626 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	justestPath = "github.com/arikkfir/justest"
+	assertPath  = "github.com/stretchr/testify/assert"
+	requirePath = "github.com/stretchr/testify/require"
+	gomegaPath  = "github.com/onsi/gomega"
+	todoPrefix  = "TODO(justest-migrate): "
+)
+
+// edit replaces the source between the start and end offsets with the given text.
+type edit struct {
+	start, end int
+	text       string
+}
+
+// scope holds the information the migration needs about a function and its enclosing functions.
+type scope struct {
+	// t is the name of the function's *testing.T (or *testing.B, etc.) parameter, if any
+	t string
+
+	// gomegas maps local Gomega instances (e.g. "g := NewWithT(t)") to the text of their T
+	gomegas map[*ast.Object]string
+
+	// testifies maps local testify assertion objects (e.g. "a := assert.New(t)") to the text of their T
+	testifies map[*ast.Object]string
+}
+
+// migrator migrates a single file.
+type migrator struct {
+	fset     *token.FileSet
+	file     *ast.File
+	src      []byte
+	q        string
+	testify  map[string]bool
+	gomega   string
+	edits    []edit
+	scopes   []*scope
+	usesTime bool
+	migrated bool
+}
+
+// Migrate rewrites the testify and Gomega assertions in the given source file to justest assertions, and returns the
+// resulting source. Assertions that cannot be converted are left in place with a TODO comment.
+func Migrate(filename string, src []byte) ([]byte, error) {
+	result, gomegaRemains, err := migrate(filename, src, false)
+	if err != nil {
+		return nil, err
+	} else if gomegaRemains {
+		// Gomega is dot-imported and still used after the migration; since both packages export some of the same
+		// names (e.g. "BeNil"), justest cannot be dot-imported as well
+		result, _, err = migrate(filename, src, true)
+	}
+	return result, err
+}
+
+// migrate performs the migration of the given source, and returns the migrated source and whether a dot-import of
+// Gomega is still required by it.
+func migrate(filename string, src []byte, qualified bool) ([]byte, bool, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, false, err
+	}
+
+	m := &migrator{fset: fset, file: file, src: src, testify: make(map[string]bool)}
+	justestName := ""
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := importName(spec)
+		switch path {
+		case assertPath, requirePath:
+			m.testify[name] = true
+		case gomegaPath:
+			m.gomega = name
+		case justestPath:
+			justestName = name
+		}
+	}
+	if len(m.testify) == 0 && m.gomega == "" {
+		return src, false, nil
+	}
+
+	switch {
+	case justestName == ".":
+		m.q = ""
+	case justestName != "":
+		m.q = justestName + "."
+	case qualified:
+		m.q = "justest."
+	}
+
+	var stack []ast.Node
+	ast.Inspect(file, func(n ast.Node) bool {
+		if n != nil {
+			stack = append(stack, n)
+			switch node := n.(type) {
+			case *ast.FuncDecl:
+				m.scopes = append(m.scopes, newScope(node.Type))
+			case *ast.FuncLit:
+				m.scopes = append(m.scopes, newScope(node.Type))
+			}
+			return true
+		}
+
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		switch node := node.(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			m.scopes = m.scopes[:len(m.scopes)-1]
+		case *ast.AssignStmt:
+			m.registerInstance(node)
+		case *ast.ExprStmt:
+			m.migrateStmt(node)
+		}
+		return true
+	})
+
+	result := applyEdits(src, m.edits)
+	result, gomegaRemains, err := m.cleanup(filename, result, justestName)
+	if err != nil {
+		return nil, false, err
+	}
+	return result, gomegaRemains, nil
+}
+
+func newScope(funcType *ast.FuncType) *scope {
+	s := &scope{gomegas: make(map[*ast.Object]string), testifies: make(map[*ast.Object]string)}
+	if funcType.Params != nil {
+		for _, field := range funcType.Params.List {
+			if star, ok := field.Type.(*ast.StarExpr); ok {
+				if sel, ok := star.X.(*ast.SelectorExpr); ok && isIdent(sel.X, "testing") && len(field.Names) == 1 {
+					switch sel.Sel.Name {
+					case "T", "B", "F":
+						s.t = field.Names[0].Name
+					}
+				}
+			} else if sel, ok := field.Type.(*ast.SelectorExpr); ok && isIdent(sel.X, "testing") && sel.Sel.Name == "TB" && len(field.Names) == 1 {
+				s.t = field.Names[0].Name
+			}
+		}
+	}
+	return s
+}
+
+// t returns the name of the nearest testing.T available to the current function.
+func (m *migrator) t() string {
+	for i := len(m.scopes) - 1; i >= 0; i-- {
+		if m.scopes[i].t != "" {
+			return m.scopes[i].t
+		}
+	}
+	return ""
+}
+
+// gomegaInstance returns the text of the T of the given local Gomega instance, if it is one.
+func (m *migrator) gomegaInstance(ident *ast.Ident) (string, bool) {
+	for i := len(m.scopes) - 1; ident.Obj != nil && i >= 0; i-- {
+		if t, ok := m.scopes[i].gomegas[ident.Obj]; ok {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// testifyInstance returns the text of the T of the given local testify assertion object, if it is one.
+func (m *migrator) testifyInstance(ident *ast.Ident) (string, bool) {
+	for i := len(m.scopes) - 1; ident.Obj != nil && i >= 0; i-- {
+		if t, ok := m.scopes[i].testifies[ident.Obj]; ok {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// registerInstance records local Gomega instances (e.g. "g := NewWithT(t)") and testify assertion objects (e.g.
+// "a := assert.New(t)") defined by the given assignment.
+func (m *migrator) registerInstance(assign *ast.AssignStmt) {
+	if len(m.scopes) == 0 || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return
+	}
+	ident, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || ident.Obj == nil {
+		return
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return
+	}
+	current := m.scopes[len(m.scopes)-1]
+	if name, ok := m.gomegaRef(call.Fun); ok && (name == "NewWithT" || name == "NewGomegaWithT") {
+		current.gomegas[ident.Obj] = m.textOf(call.Args[0])
+	} else if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "New" && m.isTestifyPkg(sel.X) {
+		current.testifies[ident.Obj] = m.textOf(call.Args[0])
+	}
+}
+
+// migrateStmt migrates the given statement, if it is a testify or Gomega assertion.
+func (m *migrator) migrateStmt(stmt *ast.ExprStmt) {
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok {
+		return
+	}
+
+	text, reason, handled := m.testifyAssertion(call)
+	if !handled {
+		text, reason, handled = m.gomegaAssertion(call)
+	}
+	if !handled {
+		return
+	} else if reason != "" {
+		m.todo(stmt, reason)
+	} else {
+		m.replace(stmt, text)
+		m.migrated = true
+	}
+}
+
+// assertion returns the text of a justest assertion; the description, if given, must start with a format string (see
+// isStringExpr).
+func (m *migrator) assertion(t string, description []ast.Expr, actual, matcher, evaluation string) string {
+	sb := strings.Builder{}
+	sb.WriteString(m.q + "With(" + t + ")")
+	if len(description) > 0 {
+		sb.WriteString(".EnsureThat(" + m.textsOf(description) + ").ByVerifying(" + actual + ")")
+	} else {
+		sb.WriteString(".VerifyThat(" + actual + ")")
+	}
+	sb.WriteString(".Will(" + matcher + ")." + evaluation)
+	return sb.String()
+}
+
+// pollFunc returns the text of a function, to be used as the actual value of a timed assertion, that calls the given
+// function and applies the given matcher to its result.
+func (m *migrator) pollFunc(f, matcher string) string {
+	return "func(t " + m.q + "T) {\n" + m.q + "With(t).VerifyThat(" + f + "()).Will(" + matcher + ").Now()\n}"
+}
+
+// call returns the text of a call to the given justest function.
+func (m *migrator) call(name string, args ...string) string {
+	return m.q + name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// not returns the text of the negation of the given matcher.
+func (m *migrator) not(matcher string) string {
+	return m.call("Not", matcher)
+}
+
+// duration returns the text of the given duration expression, converting literal strings (e.g. "5s") and numbers of
+// seconds (as accepted by Gomega) to time.Duration expressions.
+func (m *migrator) duration(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok {
+		return m.textOf(expr), true
+	}
+	switch lit.Kind {
+	case token.STRING:
+		s, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return "", false
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return "", false
+		}
+		return m.formatDuration(d), true
+	case token.INT, token.FLOAT:
+		seconds, err := strconv.ParseFloat(lit.Value, 64)
+		if err != nil {
+			return "", false
+		}
+		return m.formatDuration(time.Duration(seconds * float64(time.Second))), true
+	default:
+		return m.textOf(expr), true
+	}
+}
+
+// formatDuration returns the text of a time.Duration expression for the given duration, e.g. "5 * time.Second".
+func (m *migrator) formatDuration(d time.Duration) string {
+	m.usesTime = true
+	units := []struct {
+		name string
+		d    time.Duration
+	}{
+		{"time.Hour", time.Hour},
+		{"time.Minute", time.Minute},
+		{"time.Second", time.Second},
+		{"time.Millisecond", time.Millisecond},
+		{"time.Microsecond", time.Microsecond},
+		{"time.Nanosecond", time.Nanosecond},
+	}
+	for _, unit := range units {
+		if d%unit.d == 0 {
+			if d == unit.d {
+				return unit.name
+			}
+			return fmt.Sprintf("%d * %s", d/unit.d, unit.name)
+		}
+	}
+	panic("unreachable")
+}
+
+// isStringExpr returns true if the given expression is known to be a string without type information, i.e. a string
+// literal, a concatenation including one, or a call to one of fmt's Sprint functions.
+func isStringExpr(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return e.Kind == token.STRING
+	case *ast.ParenExpr:
+		return isStringExpr(e.X)
+	case *ast.BinaryExpr:
+		return e.Op == token.ADD && (isStringExpr(e.X) || isStringExpr(e.Y))
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return false
+		}
+		ident, ok := sel.X.(*ast.Ident)
+		return ok && ident.Obj == nil && ident.Name == "fmt" && strings.HasPrefix(sel.Sel.Name, "Sprint")
+	default:
+		return false
+	}
+}
+
+// regexpLiteral returns the text of a string literal holding a regular expression that matches the value of the given
+// string literal, wrapped by the given prefix and suffix (e.g. "^" and "$").
+func regexpLiteral(expr ast.Expr, prefix, suffix string) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	pattern := prefix + regexp.QuoteMeta(s) + suffix
+	if strings.ContainsAny(pattern, "`\r\n") {
+		return strconv.Quote(pattern), true
+	}
+	return "`" + pattern + "`", true
+}
+
+// offset returns the byte offset of the given position in the source.
+func (m *migrator) offset(pos token.Pos) int {
+	return m.fset.Position(pos).Offset
+}
+
+// textOf returns the source text of the given node, with any edits made inside it applied.
+func (m *migrator) textOf(node ast.Node) string {
+	start, end := m.offset(node.Pos()), m.offset(node.End())
+	var inner []edit
+	for _, e := range m.edits {
+		if e.start >= start && e.end <= end {
+			inner = append(inner, edit{start: e.start - start, end: e.end - start, text: e.text})
+		}
+	}
+	return string(applyEdits(m.src[start:end], inner))
+}
+
+// textsOf returns the comma-separated source texts of the given nodes.
+func (m *migrator) textsOf(exprs []ast.Expr) string {
+	texts := make([]string, len(exprs))
+	for i, expr := range exprs {
+		texts[i] = m.textOf(expr)
+	}
+	return strings.Join(texts, ", ")
+}
+
+// replace replaces the given node with the given text; edits inside the node are expected to already be incorporated
+// in the text (e.g. via textOf), and are therefore dropped.
+func (m *migrator) replace(node ast.Node, text string) {
+	start, end := m.offset(node.Pos()), m.offset(node.End())
+	edits := m.edits[:0]
+	for _, e := range m.edits {
+		if e.start < start || e.end > end {
+			edits = append(edits, e)
+		}
+	}
+	m.edits = append(edits, edit{start: start, end: end, text: text})
+}
+
+// todo adds a TODO comment with the given reason above the given statement.
+func (m *migrator) todo(stmt ast.Stmt, reason string) {
+	start := m.offset(stmt.Pos())
+	lineStart := bytes.LastIndexByte(m.src[:start], '\n') + 1
+	indent := m.src[lineStart:start]
+	if len(bytes.TrimSpace(indent)) > 0 {
+		indent = nil
+		lineStart = start
+	}
+	comment := "// " + todoPrefix + reason
+	if prevLineStart := bytes.LastIndexByte(m.src[:max(lineStart-1, 0)], '\n') + 1; prevLineStart < lineStart {
+		if string(bytes.TrimSpace(m.src[prevLineStart:lineStart])) == comment {
+			// Already marked by a previous migration
+			return
+		}
+	}
+	m.edits = append(m.edits, edit{start: lineStart, end: lineStart, text: string(indent) + comment + "\n"})
+}
+
+// applyEdits applies the given non-overlapping edits to the given source.
+func applyEdits(src []byte, edits []edit) []byte {
+	sorted := append([]edit(nil), edits...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].start < sorted[j].start })
+
+	var buf bytes.Buffer
+	last := 0
+	for _, e := range sorted {
+		buf.Write(src[last:e.start])
+		buf.WriteString(e.text)
+		last = e.end
+	}
+	buf.Write(src[last:])
+	return buf.Bytes()
+}
+
+// cleanup removes definitions of Gomega instances & testify assertion objects that are no longer used, as well as
+// imports of testify & Gomega that are no longer used, adds the justest (and if necessary, "time") imports, and
+// formats the result. It also returns whether a dot-import of Gomega is still needed.
+func (m *migrator) cleanup(filename string, src []byte, justestName string) ([]byte, bool, error) {
+	src, err := m.removeUnusedInstances(filename, src)
+	if err != nil {
+		return nil, false, err
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed parsing migrated source: %w", err)
+	}
+
+	// Check if testify & Gomega are still used
+	usedPackages := make(map[string]bool)
+	gomegaRemains := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Obj == nil {
+				usedPackages[ident.Name] = true
+				if ident.Name == m.gomega && sel.Sel.Name != "RegisterTestingT" {
+					gomegaRemains = true
+				}
+			}
+		}
+		return true
+	})
+	if m.gomega == "." {
+		for _, ident := range file.Unresolved {
+			if gomegaRootNames[ident.Name] {
+				gomegaRemains = true
+			}
+		}
+	}
+
+	// Remove "RegisterTestingT" calls if Gomega is no longer used
+	var edits []edit
+	if !gomegaRemains {
+		ast.Inspect(file, func(n ast.Node) bool {
+			if stmt, ok := n.(*ast.ExprStmt); ok {
+				if call, ok := stmt.X.(*ast.CallExpr); ok {
+					if name, ok := m.gomegaRef(call.Fun); ok && name == "RegisterTestingT" {
+						edits = append(edits, removeLine(fset, src, stmt))
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	// Rebuild imports
+	var std, others []string
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := importName(spec)
+		switch {
+		case (path == assertPath || path == requirePath) && !usedPackages[name]:
+			continue
+		case path == gomegaPath && !gomegaRemains:
+			continue
+		}
+		text := string(src[fset.Position(spec.Pos()).Offset:fset.Position(spec.End()).Offset])
+		if spec.Doc != nil {
+			text = string(src[fset.Position(spec.Doc.Pos()).Offset:fset.Position(spec.Doc.End()).Offset]) + "\n" + text
+		}
+		if spec.Comment != nil {
+			text += " " + string(src[fset.Position(spec.Comment.Pos()).Offset:fset.Position(spec.Comment.End()).Offset])
+		}
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			others = append(others, text)
+		} else {
+			std = append(std, text)
+		}
+	}
+	if m.usesTime && !hasImport(file, "time") {
+		std = append(std, strconv.Quote("time"))
+	}
+	if m.migrated && justestName == "" {
+		if m.q == "" {
+			others = append(others, ". "+strconv.Quote(justestPath))
+		} else {
+			others = append(others, strconv.Quote(justestPath))
+		}
+	}
+	imports := "import (\n" + strings.Join(std, "\n") + "\n\n" + strings.Join(others, "\n") + "\n)"
+	for i, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			e := removeLine(fset, src, gen)
+			if i == 0 || !isImportDecl(file.Decls[i-1]) {
+				e.text = imports + "\n"
+			}
+			edits = append(edits, e)
+		}
+	}
+
+	result, err := format.Source(applyEdits(src, edits))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed formatting migrated source: %w", err)
+	}
+	return result, gomegaRemains && m.gomega == ".", nil
+}
+
+// removeUnusedInstances removes definitions of Gomega instances & testify assertion objects (e.g. "g := NewWithT(t)")
+// that are no longer used.
+func (m *migrator) removeUnusedInstances(filename string, src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing migrated source: %w", err)
+	}
+
+	var edits []edit
+	ast.Inspect(file, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok && assign.Tok == token.DEFINE && len(assign.Lhs) == 1 && len(assign.Rhs) == 1 {
+			if ident, ok := assign.Lhs[0].(*ast.Ident); ok && ident.Obj != nil {
+				if call, ok := assign.Rhs[0].(*ast.CallExpr); ok && m.isInstanceFactory(call.Fun) && !isObjectUsed(file, ident) {
+					edits = append(edits, removeLine(fset, src, assign))
+				}
+			}
+		}
+		return true
+	})
+	return applyEdits(src, edits), nil
+}
+
+// isInstanceFactory returns true if the given function creates a Gomega instance or a testify assertion object.
+func (m *migrator) isInstanceFactory(fun ast.Expr) bool {
+	if name, ok := m.gomegaRef(fun); ok && (name == "NewWithT" || name == "NewGomegaWithT") {
+		return true
+	} else if sel, ok := fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "New" && m.isTestifyPkg(sel.X) {
+		return true
+	}
+	return false
+}
+
+// gomegaRootNames are Gomega identifiers that, if still used after the migration, require keeping the Gomega import.
+var gomegaRootNames = map[string]bool{
+	"Expect": true, "Ω": true, "ExpectWithOffset": true,
+	"Eventually": true, "EventuallyWithOffset": true, "Consistently": true, "ConsistentlyWithOffset": true,
+	"NewWithT": true, "NewGomegaWithT": true, "RegisterFailHandler": true, "Default": true,
+	"InterceptGomegaFailure": true, "InterceptGomegaFailures": true, "StopTrying": true, "TryAgainAfter": true,
+}
+
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	path, _ := strconv.Unquote(spec.Path.Value)
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+func hasImport(file *ast.File, path string) bool {
+	for _, spec := range file.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p == path {
+			return true
+		}
+	}
+	return false
+}
+
+func isImportDecl(decl ast.Decl) bool {
+	gen, ok := decl.(*ast.GenDecl)
+	return ok && gen.Tok == token.IMPORT
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+// isObjectUsed returns true if the object defined by the given identifier is referenced anywhere in the file.
+func isObjectUsed(file *ast.File, def *ast.Ident) bool {
+	used := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident != def && ident.Obj == def.Obj {
+			used = true
+		}
+		return !used
+	})
+	return used
+}
+
+// removeLine returns an edit removing the given node, along with the rest of its line if nothing else is on it.
+func removeLine(fset *token.FileSet, src []byte, node ast.Node) edit {
+	start, end := fset.Position(node.Pos()).Offset, fset.Position(node.End()).Offset
+	lineStart := bytes.LastIndexByte(src[:start], '\n') + 1
+	if len(bytes.TrimSpace(src[lineStart:start])) == 0 {
+		start = lineStart
+	}
+	if lineEnd := bytes.IndexByte(src[end:], '\n'); lineEnd >= 0 && len(bytes.TrimSpace(src[end:end+lineEnd])) == 0 {
+		end += lineEnd + 1
+	}
+	return edit{start: start, end: end}
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// testifyRule converts a testify assertion function to its justest equivalent.
+type testifyRule struct {
+	// args is the number of arguments the function expects (excluding the T, and the message & its arguments)
+	args int
+
+	// convert returns the actual value, matcher and evaluation (e.g. "Now()") of the justest assertion, or a reason
+	// why the assertion cannot be converted.
+	convert func(m *migrator, args []ast.Expr) (actual, matcher, evaluation, reason string)
+}
+
+// testifyMatcher returns a rule for a testify function whose arguments are the actual value followed by the
+// arguments of the given matcher function.
+func testifyMatcher(args int, matcher func(m *migrator, args []string) string) testifyRule {
+	return testifyRule{
+		args: args,
+		convert: func(m *migrator, args []ast.Expr) (string, string, string, string) {
+			texts := make([]string, len(args))
+			for i, arg := range args {
+				texts[i] = m.textOf(arg)
+			}
+			return texts[0], matcher(m, texts[1:]), "", ""
+		},
+	}
+}
+
+// testifyExpected returns a rule for a testify function whose arguments are the expected value (which is given to the
+// matcher) followed by the actual value.
+func testifyExpected(matcher func(m *migrator, expected string) string) testifyRule {
+	return testifyRule{
+		args: 2,
+		convert: func(m *migrator, args []ast.Expr) (string, string, string, string) {
+			return m.textOf(args[1]), matcher(m, m.textOf(args[0])), "", ""
+		},
+	}
+}
+
+// testifyErrorMessage returns a rule for a testify function checking an error's message using a string literal, which
+// is converted to a regular expression wrapped by the given prefix and suffix.
+func testifyErrorMessage(prefix, suffix string) testifyRule {
+	return testifyRule{
+		args: 2,
+		convert: func(m *migrator, args []ast.Expr) (string, string, string, string) {
+			if pattern, ok := regexpLiteral(args[1], prefix, suffix); ok {
+				return m.textOf(args[0]), m.call("MatchError", pattern), "", ""
+			}
+			return "", "", "", "error message is not a string literal"
+		},
+	}
+}
+
+// testifyCondition returns a rule for testify's Eventually & Never functions, which repeatedly call a condition
+// function; the given matcher is applied to the condition's result, and the given evaluation method is used with the
+// wait & tick durations.
+func testifyCondition(matcher func(m *migrator) string, evaluation string) testifyRule {
+	return testifyRule{
+		args: 3,
+		convert: func(m *migrator, args []ast.Expr) (string, string, string, string) {
+			actual := m.pollFunc(m.textOf(args[0]), matcher(m))
+			return actual, m.call("Succeed"), evaluation + "(" + m.textsOf(args[1:]) + ")", ""
+		},
+	}
+}
+
+// testifyContains returns a rule for testify's Contains & NotContains functions, whose meaning depends on the type of
+// the collection: strings are checked for substrings, maps for keys, and other collections for elements. Since the
+// type is only known for literals, other collections are not converted. The given function wraps the resulting
+// matcher (e.g. negates it).
+func testifyContains(wrap func(m *migrator, matcher string) string) testifyRule {
+	return testifyRule{
+		args: 2,
+		convert: func(m *migrator, args []ast.Expr) (string, string, string, string) {
+			collection, element := m.textOf(args[0]), m.textOf(args[1])
+			switch lit := args[0].(type) {
+			case *ast.BasicLit:
+				if lit.Kind != token.STRING {
+					break
+				} else if pattern, ok := regexpLiteral(args[1], "", ""); ok {
+					return collection, wrap(m, m.call("Say", pattern)), "", ""
+				}
+				return "", "", "", "substring is not a string literal"
+			case *ast.CompositeLit:
+				switch lit.Type.(type) {
+				case *ast.MapType:
+					return collection, wrap(m, m.call("HaveKey", element)), "", ""
+				case *ast.ArrayType:
+					return collection, wrap(m, m.call("ContainElement", element)), "", ""
+				}
+			}
+			return "", "", "", "cannot tell whether the collection is a string, a map or another collection"
+		},
+	}
+}
+
+// testifyElementsMatch is the rule for testify's ElementsMatch function; one of its arguments must be an array or
+// slice literal, whose elements are given to the ConsistOf matcher, while the other is the actual value.
+var testifyElementsMatch = testifyRule{
+	args: 2,
+	convert: func(m *migrator, args []ast.Expr) (string, string, string, string) {
+		for i, arg := range args {
+			lit, ok := arg.(*ast.CompositeLit)
+			if !ok {
+				continue
+			} else if _, ok := lit.Type.(*ast.ArrayType); !ok {
+				continue
+			}
+			elements := make([]string, len(lit.Elts))
+			for j, elt := range lit.Elts {
+				if _, ok := elt.(*ast.KeyValueExpr); ok {
+					return "", "", "", "indexed elements are not supported"
+				}
+				elements[j] = m.textOf(elt)
+			}
+			return m.textOf(args[1-i]), m.call("ConsistOf", elements...), "", ""
+		}
+		return "", "", "", "neither list is an array or slice literal"
+	},
+}
+
+var testifyRules = map[string]testifyRule{
+	"Equal":    testifyExpected(func(m *migrator, e string) string { return m.call("EqualTo", e) }),
+	"NotEqual": testifyExpected(func(m *migrator, e string) string { return m.not(m.call("EqualTo", e)) }),
+	"Nil":      testifyMatcher(1, func(m *migrator, _ []string) string { return m.call("BeNil") }),
+	"NotNil":   testifyMatcher(1, func(m *migrator, _ []string) string { return m.not(m.call("BeNil")) }),
+	"True":     testifyMatcher(1, func(m *migrator, _ []string) string { return m.call("EqualTo", "true") }),
+	"False":    testifyMatcher(1, func(m *migrator, _ []string) string { return m.call("EqualTo", "false") }),
+	"Empty":    testifyMatcher(1, func(m *migrator, _ []string) string { return m.call("BeEmpty") }),
+	"NotEmpty": testifyMatcher(1, func(m *migrator, _ []string) string { return m.not(m.call("BeEmpty")) }),
+	"Len":      testifyMatcher(2, func(m *migrator, a []string) string { return m.call("HaveLen", a...) }),
+
+	"Contains":      testifyContains(func(_ *migrator, matcher string) string { return matcher }),
+	"NotContains":   testifyContains((*migrator).not),
+	"ElementsMatch": testifyElementsMatch,
+
+	"NoError":       testifyMatcher(1, func(m *migrator, _ []string) string { return m.call("Succeed") }),
+	"Error":         testifyMatcher(1, func(m *migrator, _ []string) string { return m.call("Fail") }),
+	"ErrorIs":       testifyMatcher(2, func(m *migrator, a []string) string { return m.call("MatchError", a...) }),
+	"NotErrorIs":    testifyMatcher(2, func(m *migrator, a []string) string { return m.not(m.call("MatchError", a...)) }),
+	"ErrorAs":       testifyMatcher(2, func(m *migrator, a []string) string { return m.call("MatchError", a...) }),
+	"ErrorContains": testifyErrorMessage("", ""),
+	"EqualError":    testifyErrorMessage("^", "$"),
+
+	"Greater":        testifyMatcher(2, func(m *migrator, a []string) string { return m.call("BeGreaterThan", a...) }),
+	"GreaterOrEqual": testifyMatcher(2, func(m *migrator, a []string) string { return m.not(m.call("BeLessThan", a...)) }),
+	"Less":           testifyMatcher(2, func(m *migrator, a []string) string { return m.call("BeLessThan", a...) }),
+	"LessOrEqual":    testifyMatcher(2, func(m *migrator, a []string) string { return m.not(m.call("BeGreaterThan", a...)) }),
+
+	"InDelta": {
+		args: 3,
+		convert: func(m *migrator, args []ast.Expr) (string, string, string, string) {
+			return m.textOf(args[1]), m.call("BeCloseTo", m.textOf(args[0]), m.textOf(args[2])), "", ""
+		},
+	},
+	"InEpsilon": {
+		args: 3,
+		convert: func(m *migrator, args []ast.Expr) (string, string, string, string) {
+			return m.textOf(args[1]), m.call("BeCloseTo", m.textOf(args[0]), m.textOf(args[2])) + ".Relative()", "", ""
+		},
+	},
+	"Regexp": {
+		args: 2,
+		convert: func(m *migrator, args []ast.Expr) (string, string, string, string) {
+			return m.textOf(args[1]), m.call("Say", m.textOf(args[0])), "", ""
+		},
+	},
+	"NotRegexp": {
+		args: 2,
+		convert: func(m *migrator, args []ast.Expr) (string, string, string, string) {
+			return m.textOf(args[1]), m.not(m.call("Say", m.textOf(args[0]))), "", ""
+		},
+	},
+
+	"Panics":          testifyMatcher(1, func(m *migrator, _ []string) string { return m.call("Panic") }),
+	"NotPanics":       testifyMatcher(1, func(m *migrator, _ []string) string { return m.not(m.call("Panic")) }),
+	"PanicsWithValue": testifyExpected(func(m *migrator, v string) string { return m.call("PanicWith", m.call("EqualTo", v)) }),
+
+	"Eventually": testifyCondition(func(m *migrator) string { return m.call("EqualTo", "true") }, "Within"),
+	"Never":      testifyCondition(func(m *migrator) string { return m.call("EqualTo", "false") }, "For"),
+}
+
+// testifyAssertion converts the given call if it is a testify assertion, returning the justest assertion or the reason
+// why it cannot be converted; the last return value is false if the call is not a testify assertion at all.
+func (m *migrator) testifyAssertion(call *ast.CallExpr) (string, string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+
+	var t string
+	args := call.Args
+	if m.isTestifyPkg(sel.X) {
+		if len(args) == 0 {
+			return "", fmt.Sprintf("unexpected call to testify's %s without arguments", sel.Sel.Name), true
+		}
+		t, args = m.textOf(args[0]), args[1:]
+	} else if ident, ok := sel.X.(*ast.Ident); !ok {
+		return "", "", false
+	} else if t, ok = m.testifyInstance(ident); !ok {
+		return "", "", false
+	}
+
+	name := sel.Sel.Name
+	rule, ok := testifyRules[name]
+	formatted := false
+	if !ok && strings.HasSuffix(name, "f") {
+		rule, ok = testifyRules[strings.TrimSuffix(name, "f")]
+		formatted = true
+	}
+	if !ok {
+		return "", fmt.Sprintf("testify's %s has no automatic justest equivalent", name), true
+	} else if len(args) < rule.args || formatted && len(args) == rule.args {
+		return "", fmt.Sprintf("unexpected number of arguments for testify's %s", name), true
+	} else if len(args) > rule.args && !isStringExpr(args[rule.args]) {
+		return "", "message is not a string literal", true
+	}
+
+	actual, matcher, evaluation, reason := rule.convert(m, args[:rule.args])
+	if reason != "" {
+		return "", reason, true
+	} else if evaluation == "" {
+		evaluation = "Now()"
+	}
+	return m.assertion(t, args[rule.args:], actual, matcher, evaluation), "", true
+}
+
+// isTestifyPkg returns true if the given expression refers to one of the imported testify packages.
+func (m *migrator) isTestifyPkg(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Obj == nil && m.testify[ident.Name]
+}
@@ -0,0 +1,78 @@
+package justest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+)
+
+// httpBodyDescriptionLimit is the maximum number of body bytes included in the description of an HTTP response.
+const httpBodyDescriptionLimit = 1024
+
+var (
+	httpValueExtractor ValueExtractor
+)
+
+func init() {
+	httpValueExtractor = NewValueExtractor(ExtractSameValue)
+	httpValueExtractor[reflect.Chan] = NewChannelExtractor(httpValueExtractor, true)
+	httpValueExtractor[reflect.Func] = NewFuncExtractor(httpValueExtractor, true)
+}
+
+// extractHTTPResponse returns the HTTP response of the given actual value, which is either an *http.Response or an
+// *httptest.ResponseRecorder (or a function or channel providing one), failing the given matcher otherwise.
+func extractHTTPResponse(t T, matcherName string, actual any) *http.Response {
+	GetHelper(t).Helper()
+	switch v := httpValueExtractor.MustExtractValue(t, actual).(type) {
+	case *http.Response:
+		if v != nil {
+			return v
+		}
+	case *httptest.ResponseRecorder:
+		if v != nil {
+			return v.Result()
+		}
+	}
+	t.Fatalf("Unsupported actual value for %s matcher: %+v (%T)", matcherName, actual, actual)
+	panic("unreachable")
+}
+
+// readHTTPBody reads the entire body of the given response, and then restores it, so it can be read again (e.g. by
+// other matchers, or by the test itself).
+func readHTTPBody(resp *http.Response) ([]byte, error) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return body, err
+}
+
+// describeHTTPResponse returns the status line, headers and (possibly truncated) body of the given response.
+func describeHTTPResponse(resp *http.Response) string {
+	sb := strings.Builder{}
+	status := resp.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	sb.WriteString(strings.TrimSpace(resp.Proto + " " + status))
+	sb.WriteString("\n")
+	headers := strings.Builder{}
+	_ = resp.Header.Write(&headers)
+	sb.WriteString(strings.ReplaceAll(headers.String(), "\r\n", "\n"))
+
+	body, err := readHTTPBody(resp)
+	if err != nil {
+		sb.WriteString(fmt.Sprintf("\n<failed reading body: %v>", err))
+	} else if len(body) > httpBodyDescriptionLimit {
+		sb.WriteString(fmt.Sprintf("\n%s... (%d more bytes)", body[:httpBodyDescriptionLimit], len(body)-httpBodyDescriptionLimit))
+	} else if len(body) > 0 {
+		sb.WriteString("\n" + string(body))
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
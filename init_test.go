@@ -1,7 +1,16 @@
 package justest_test
 
-import "os"
+import (
+	"os"
+	"testing"
+
+	"github.com/arikkfir/justest"
+)
 
 func init() {
 	_ = os.Setenv("JUSTEST_DISABLE_SOURCE_HIGHLIGHT", "false")
 }
+
+func TestMain(m *testing.M) {
+	os.Exit(justest.RunWithSnapshots(m))
+}
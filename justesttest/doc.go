@@ -0,0 +1,13 @@
+// Package justesttest provides utilities for testing custom justest matchers.
+//
+// It provides MockT, a recording implementation of justest.T, and a set of verifiers that check the outcome of an
+// assertion made with it. A typical test of a custom matcher looks like this:
+//
+//	func TestBeSuperDuper(t *testing.T) {
+//		mt := justesttest.NewMockT(t)
+//		defer mt.Verify(justesttest.FailureVerifier(`is not super-duper`))
+//		With(mt).VerifyThat("meh").Will(BeSuperDuper(false)).Now()
+//	}
+//
+// Note that MockT.Verify must be deferred directly, since it recovers the panic MockT raises when it fails.
+package justesttest
@@ -0,0 +1,114 @@
+package justesttest
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	. "github.com/arikkfir/justest"
+)
+
+// Message is a failure or log message recorded by MockT.
+type Message struct {
+	// Format is the format of the message, or nil if it was recorded by Log (which has no format).
+	Format *string
+
+	// Args are the arguments of the message.
+	Args []any
+}
+
+// String returns the formatted message.
+func (m Message) String() string {
+	if m.Format != nil {
+		return fmt.Sprintf(*m.Format, m.Args...)
+	}
+	return fmt.Sprint(m.Args...)
+}
+
+// MatchesRegexp returns true if the formatted message matches the given regular expression.
+func (m Message) MatchesRegexp(re *regexp.Regexp) bool {
+	return re.MatchString(m.String())
+}
+
+// MockT is a T implementation that records failures, log messages and cleanup functions instead of acting on them.
+// Calling Fatalf records the failure and panics with the MockT itself, in order to stop the assertion just like a real
+// testing.T would; that panic is recovered by Verify.
+type MockT struct {
+	Parent      T
+	Cleanups    []func()
+	LogMessages []Message
+	Failures    []Message
+	mutex       sync.Mutex
+}
+
+//go:noinline
+func NewMockT(parent T) *MockT {
+	return &MockT{Parent: parent}
+}
+
+//go:noinline
+func (t *MockT) GetParent() T { return t.Parent }
+
+//go:noinline
+func (t *MockT) Name() string { GetHelper(t).Helper(); return t.Parent.Name() }
+
+//go:noinline
+func (t *MockT) Cleanup(f func()) {
+	GetHelper(t).Helper()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.Cleanups = append(t.Cleanups, f)
+}
+
+//go:noinline
+func (t *MockT) Fatalf(format string, args ...any) {
+	GetHelper(t).Helper()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.Failures = append(t.Failures, Message{Format: &format, Args: args})
+	panic(t)
+}
+
+//go:noinline
+func (t *MockT) Failed() bool {
+	GetHelper(t).Helper()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return len(t.Failures) > 0
+}
+
+//go:noinline
+func (t *MockT) Log(args ...any) {
+	GetHelper(t).Helper()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.LogMessages = append(t.LogMessages, Message{Args: args})
+}
+
+//go:noinline
+func (t *MockT) Logf(format string, args ...any) {
+	GetHelper(t).Helper()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.LogMessages = append(t.LogMessages, Message{Format: &format, Args: args})
+}
+
+// Verify recovers the outcome of the test (a failure or a panic) and checks it using the given verifiers. It must be
+// deferred directly (e.g. "defer mt.Verify(SuccessVerifier())"), otherwise it will not be able to recover panics.
+func (t *MockT) Verify(verifiers ...TestOutcomeVerifier) {
+	GetHelper(t).Helper()
+	if root := GetRoot(t); root != nil && root.Failed() {
+		root.Log("Root T has already failed, no point verifying anything else")
+		return
+	}
+
+	r := recover()
+	if _, ok := r.(*MockT); ok && r == t {
+		// MockT failed, it's not a real panic
+		r = nil
+	}
+
+	for _, v := range verifiers {
+		v(t, r)
+	}
+}
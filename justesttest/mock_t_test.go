@@ -0,0 +1,84 @@
+package justesttest_test
+
+import (
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestMockT(t *testing.T) {
+	t.Parallel()
+	t.Run("Records failures", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer func() {
+			if r := recover(); r != mt {
+				t.Fatalf("Expected MockT to panic with itself, but got: %+v", r)
+			}
+			if !mt.Failed() {
+				t.Fatalf("Expected MockT to be marked as failed")
+			}
+			if len(mt.Failures) != 1 || mt.Failures[0].String() != "failure 1" {
+				t.Fatalf("Unexpected failures recorded: %+v", mt.Failures)
+			}
+		}()
+		mt.Fatalf("failure %d", 1)
+	})
+	t.Run("Records log messages and cleanups", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		mt.Log("message", 1)
+		mt.Logf("message %d", 2)
+		mt.Cleanup(func() {})
+		if len(mt.LogMessages) != 2 || mt.LogMessages[0].String() != "message1" || mt.LogMessages[1].String() != "message 2" {
+			t.Fatalf("Unexpected log messages recorded: %+v", mt.LogMessages)
+		}
+		if len(mt.Cleanups) != 1 {
+			t.Fatalf("Expected 1 cleanup to be recorded, got %d", len(mt.Cleanups))
+		}
+		if mt.Failed() {
+			t.Fatalf("Expected MockT not to be marked as failed")
+		}
+	})
+	t.Run("Delegates name to parent", func(t *testing.T) {
+		t.Parallel()
+		if name := NewMockT(t).Name(); name != t.Name() {
+			t.Fatalf("Expected name '%s', got '%s'", t.Name(), name)
+		}
+	})
+}
+
+func TestVerifiers(t *testing.T) {
+	t.Parallel()
+	t.Run("SuccessVerifier accepts passing matcher", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		With(mt).VerifyThat(1).Will(EqualTo(1)).Now()
+	})
+	t.Run("FailureVerifier accepts failing matcher", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^custom failure: 1\n`))
+		With(mt).VerifyThat(1).Will(MatcherFunc(func(t T, actuals ...any) { t.Fatalf("custom failure: %v", actuals[0]) })).Now()
+	})
+	t.Run("PanicVerifier accepts panicking matcher", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(PanicVerifier(`^custom panic$`))
+		With(mt).VerifyThat(1).Will(MatcherFunc(func(t T, actuals ...any) { panic("custom panic") })).Now()
+	})
+	t.Run("Multiple verifiers are all applied", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		invoked := false
+		defer func() {
+			if !invoked {
+				t.Fatalf("Custom verifier was not invoked")
+			}
+		}()
+		defer mt.Verify(SuccessVerifier(), func(mt *MockT, recovered any) { invoked = true })
+		With(mt).VerifyThat(1).Will(EqualTo(1)).Now()
+	})
+}
@@ -1,94 +1,18 @@
-package justest_test
+package justesttest
 
 import (
 	"fmt"
 	"regexp"
-	"sync"
 
 	. "github.com/arikkfir/justest"
-	"github.com/arikkfir/justest/internal"
 )
 
+// TestOutcomeVerifier verifies the outcome of a test performed with a MockT. The recovered value is the panic that
+// occurred during the test (if any), excluding the panic MockT raises when it fails.
 type TestOutcomeVerifier func(t *MockT, recovered any)
 
-type MockT struct {
-	Parent      T
-	Cleanups    []func()
-	LogMessages []internal.FormatAndArgs
-	Failures    []internal.FormatAndArgs
-	mutex       sync.Mutex
-}
-
-//go:noinline
-func NewMockT(parent T) *MockT {
-	return &MockT{Parent: parent}
-}
-
-//go:noinline
-func (t *MockT) GetParent() T { return t.Parent }
-
-//go:noinline
-func (t *MockT) Name() string { GetHelper(t).Helper(); return t.Parent.Name() }
-
-//go:noinline
-func (t *MockT) Cleanup(f func()) {
-	GetHelper(t).Helper()
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
-	t.Cleanups = append(t.Cleanups, f)
-}
-
-//go:noinline
-func (t *MockT) Fatalf(format string, args ...any) {
-	GetHelper(t).Helper()
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
-	t.Failures = append(t.Failures, internal.FormatAndArgs{Format: &format, Args: args})
-	panic(t)
-}
-
-//go:noinline
-func (t *MockT) Failed() bool {
-	GetHelper(t).Helper()
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
-	return len(t.Failures) > 0
-}
-
-//go:noinline
-func (t *MockT) Log(args ...any) {
-	GetHelper(t).Helper()
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
-	t.LogMessages = append(t.LogMessages, internal.FormatAndArgs{Args: args})
-}
-
-//go:noinline
-func (t *MockT) Logf(format string, args ...any) {
-	GetHelper(t).Helper()
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
-	t.LogMessages = append(t.LogMessages, internal.FormatAndArgs{Format: &format, Args: args})
-}
-
-func (t *MockT) Verify(verifiers ...TestOutcomeVerifier) {
-	GetHelper(t).Helper()
-	if root := GetRoot(t); root != nil && root.Failed() {
-		root.Log("Root T has already failed, no point verifying anything else")
-		return
-	}
-
-	r := recover()
-	if _, ok := r.(*MockT); ok && r == t {
-		// MockT failed, it's not a real panic
-		r = nil
-	}
-
-	for _, v := range verifiers {
-		v(t, r)
-	}
-}
-
+// FailureVerifier verifies that the test failed, and that each recorded failure matches at least one of the given
+// regular expressions.
 func FailureVerifier(patterns ...string) TestOutcomeVerifier {
 	compiledPatterns := make([]*regexp.Regexp, len(patterns))
 	for i, pattern := range patterns {
@@ -122,6 +46,8 @@ func FailureVerifier(patterns ...string) TestOutcomeVerifier {
 	}
 }
 
+// PanicVerifier verifies that the test panicked, and that the panic matches at least one of the given regular
+// expressions.
 func PanicVerifier(patterns ...string) TestOutcomeVerifier {
 	compiledPatterns := make([]*regexp.Regexp, len(patterns))
 	for i, pattern := range patterns {
@@ -146,6 +72,7 @@ func PanicVerifier(patterns ...string) TestOutcomeVerifier {
 	}
 }
 
+// SuccessVerifier verifies that the test neither failed nor panicked.
 func SuccessVerifier() TestOutcomeVerifier {
 	return func(t *MockT, recovered any) {
 		GetHelper(t).Helper()
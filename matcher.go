@@ -1,9 +1,28 @@
 package justest
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 type Matcher interface {
 	Assert(t T, actuals ...any)
 }
 
+// DescribedMatcher is an optional interface that matchers can implement in order to describe their expectation. The
+// description is used by combinators such as Not, AllOf or AnyOf, as well as by timed assertions, to produce failure
+// messages such as "Expected 5 not to be between 1 and 10".
+type DescribedMatcher interface {
+	Matcher
+
+	// Describe returns a description of the matcher's expectation, e.g. "to be between 1 and 10".
+	Describe() string
+
+	// DescribeNegation returns a description of the negated expectation, e.g. "not to be between 1 and 10".
+	DescribeNegation() string
+}
+
 type MatcherFunc func(t T, actuals ...any)
 
 //go:noinline
@@ -11,3 +30,68 @@ func (f MatcherFunc) Assert(t T, actuals ...any) {
 	GetHelper(t).Helper()
 	f(t, actuals...)
 }
+
+type describedMatcher struct {
+	matcher     Matcher
+	description string
+	negation    string
+}
+
+//go:noinline
+func (m *describedMatcher) Assert(t T, actuals ...any) {
+	GetHelper(t).Helper()
+	m.matcher.Assert(t, actuals...)
+}
+
+func (m *describedMatcher) Describe() string { return m.description }
+
+func (m *describedMatcher) DescribeNegation() string { return m.negation }
+
+// Described returns a DescribedMatcher that delegates to the given matcher, and describes it using the given
+// description and negated description.
+//
+//go:noinline
+func Described(m Matcher, description, negation string) DescribedMatcher {
+	return &describedMatcher{matcher: m, description: description, negation: negation}
+}
+
+// describe returns the description of the given matcher, or a generic description if it is not a DescribedMatcher.
+func describe(m Matcher) string {
+	if dm, ok := m.(DescribedMatcher); ok {
+		return dm.Describe()
+	}
+	return "to match"
+}
+
+// describeNegation returns the negated description of the given matcher, or a generic description if it is not a
+// DescribedMatcher.
+func describeNegation(m Matcher) string {
+	if dm, ok := m.(DescribedMatcher); ok {
+		return dm.DescribeNegation()
+	}
+	return "not to match"
+}
+
+// describeValue formats the given value for inclusion in a description.
+func describeValue(v any) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprintf("%+v", v)
+}
+
+// describeValues formats the given values for inclusion in a description.
+func describeValues(values []any) string {
+	switch len(values) {
+	case 0:
+		return "nothing"
+	case 1:
+		return describeValue(values[0])
+	default:
+		descriptions := make([]string, len(values))
+		for i, v := range values {
+			descriptions[i] = describeValue(v)
+		}
+		return "[" + strings.Join(descriptions, ", ") + "]"
+	}
+}
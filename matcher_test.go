@@ -0,0 +1,67 @@
+package justest_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestDescribedMatchers(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		matcher            Matcher
+		description        string
+		negatedDescription string
+	}
+	testCases := map[string]testCase{
+		"AllOf":            {matcher: AllOf(BeGreaterThan(1), BeLessThan(10)), description: "to be greater than 1 and to be less than 10", negatedDescription: "not to be greater than 1 or not to be less than 10"},
+		"AnyOf":            {matcher: AnyOf(BeNil(), BeEmpty()), description: "to be nil or to be empty", negatedDescription: "not to be nil and not to be empty"},
+		"BeBetween":        {matcher: BeBetween(1, 10), description: "to be between 1 and 10", negatedDescription: "not to be between 1 and 10"},
+		"BeCloseTo":        {matcher: BeCloseTo(1, 0.5), description: "to be within 0.5 of 1", negatedDescription: "not to be within 0.5 of 1"},
+		"BeCloseTo ULPs":   {matcher: BeCloseTo(1, 4).ULPs(), description: "to be within 4 ULPs of 1", negatedDescription: "not to be within 4 ULPs of 1"},
+		"BeEmpty":          {matcher: BeEmpty(), description: "to be empty", negatedDescription: "not to be empty"},
+		"BeGreaterThan":    {matcher: BeGreaterThan(1), description: "to be greater than 1", negatedDescription: "not to be greater than 1"},
+		"BeInf":            {matcher: BeInf(), description: "to be infinite", negatedDescription: "not to be infinite"},
+		"BeLessThan":       {matcher: BeLessThan(1), description: "to be less than 1", negatedDescription: "not to be less than 1"},
+		"BeNaN":            {matcher: BeNaN(), description: "to be NaN", negatedDescription: "not to be NaN"},
+		"BeNil":            {matcher: BeNil(), description: "to be nil", negatedDescription: "not to be nil"},
+		"ConsistOf":        {matcher: ConsistOf(1, BeNil()), description: "to consist of element 1, an element expected to be nil", negatedDescription: "not to consist of element 1, an element expected to be nil"},
+		"ContainElement":   {matcher: ContainElement(1), description: "to contain element 1", negatedDescription: "not to contain element 1"},
+		"ContainElements":  {matcher: ContainElements(1, 2), description: "to contain element 1, element 2", negatedDescription: "not to contain element 1, element 2"},
+		"EqualTo":          {matcher: EqualTo("abc"), description: `to equal "abc"`, negatedDescription: `not to equal "abc"`},
+		"Fail":             {matcher: Fail(), description: "to fail", negatedDescription: "not to fail"},
+		"Fail patterns":    {matcher: Fail("^a$"), description: "to fail with an error matching one of [^a$]", negatedDescription: "not to fail with an error matching any of [^a$]"},
+		"HaveKey":          {matcher: HaveKey("a"), description: `to have key "a"`, negatedDescription: `not to have key "a"`},
+		"HaveKeyWithValue": {matcher: HaveKeyWithValue("a", 1), description: `to have key "a" with value 1`, negatedDescription: `not to have key "a" with value 1`},
+		"HaveLen":          {matcher: HaveLen(3), description: "to have a length of 3", negatedDescription: "not to have a length of 3"},
+		"MatchError":       {matcher: MatchError("abc"), description: "to fail with a message matching 'abc'", negatedDescription: "not to fail with a message matching 'abc'"},
+		"NoneOf":           {matcher: NoneOf(BeNil(), BeEmpty()), description: "not to be nil and not to be empty", negatedDescription: "to be nil or to be empty"},
+		"Not":              {matcher: Not(BeNil()), description: "not to be nil", negatedDescription: "to be nil"},
+		"Not undescribed":  {matcher: Not(MatcherFunc(func(T, ...any) {})), description: "not to match", negatedDescription: "to match"},
+		"Panic":            {matcher: Panic(), description: "to panic", negatedDescription: "not to panic"},
+		"PanicWith":        {matcher: PanicWith(EqualTo(1)), description: "to panic with a value expected to equal 1", negatedDescription: "not to panic with a value expected to equal 1"},
+		"Say":              {matcher: Say("^abc$"), description: "to match '^abc$'", negatedDescription: "not to match '^abc$'"},
+		"Succeed":          {matcher: Succeed(), description: "to succeed", negatedDescription: "not to succeed"},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			dm, ok := tc.matcher.(DescribedMatcher)
+			if !ok {
+				t.Fatalf("Matcher %T is not a DescribedMatcher", tc.matcher)
+			}
+			With(t).VerifyThat(dm.Describe()).Will(EqualTo(tc.description)).Now()
+			With(t).VerifyThat(dm.DescribeNegation()).Will(EqualTo(tc.negatedDescription)).Now()
+		})
+	}
+}
+
+func TestDescribedMatcherInTimeoutMessage(t *testing.T) {
+	t.Parallel()
+	mt := NewMockT(t)
+	defer mt.Verify(FailureVerifier(`^Expected actual value 5 to be greater than 10\nTimed out after .+ waiting for assertion to pass\nExpected 5 to be greater than 10\n`))
+	With(mt).VerifyThat(5).Will(BeGreaterThan(10)).Within(200*time.Millisecond, 50*time.Millisecond)
+}
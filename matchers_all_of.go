@@ -0,0 +1,35 @@
+package justest
+
+import (
+	"strings"
+)
+
+//go:noinline
+func AllOf(matchers ...Matcher) Matcher {
+	if len(matchers) == 0 {
+		panic("expected at least one matcher")
+	}
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+
+		var failures []string
+		for _, matcher := range matchers {
+			if failure := tryAssert(t, matcher, actuals...); failure != nil {
+				failures = append(failures, failure.String())
+			}
+		}
+
+		if len(failures) > 0 {
+			t.Fatalf("Expected all %d matchers to match, but %d did not:%s", len(matchers), len(failures), formatFailures(failures))
+		}
+	})
+
+	descriptions := make([]string, len(matchers))
+	negations := make([]string, len(matchers))
+	for i, matcher := range matchers {
+		descriptions[i] = describe(matcher)
+		negations[i] = describeNegation(matcher)
+	}
+	return Described(m, strings.Join(descriptions, " and "), strings.Join(negations, " or "))
+}
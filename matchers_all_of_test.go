@@ -0,0 +1,58 @@
+package justest_test
+
+import (
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestAllOf(t *testing.T) {
+	t.Parallel()
+	succeeding := MatcherFunc(func(t T, actuals ...any) {})
+	failing := func(msg string) MatcherFunc {
+		return func(t T, actuals ...any) { t.Fatalf("%s", msg); panic("unreachable") }
+	}
+	type testCase struct {
+		actuals  []any
+		matchers []Matcher
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"All matchers succeed": {
+			actuals:  []any{5},
+			matchers: []Matcher{BeGreaterThan(1), BeLessThan(10), succeeding},
+			verifier: SuccessVerifier(),
+		},
+		"Single failure is reported": {
+			actuals:  []any{5},
+			matchers: []Matcher{succeeding, failing("failure 1")},
+			verifier: FailureVerifier(`^Expected all 2 matchers to match, but 1 did not:\n\[1\] failure 1\n`),
+		},
+		"All failures are reported": {
+			actuals:  []any{5},
+			matchers: []Matcher{failing("failure 1"), succeeding, failing("failure 2")},
+			verifier: FailureVerifier(`^Expected all 3 matchers to match, but 2 did not:\n\[1\] failure 1\n\[2\] failure 2\n`),
+		},
+		"Panicking matcher re-panics": {
+			actuals:  []any{5},
+			matchers: []Matcher{MatcherFunc(func(t T, actual ...any) { panic("panic propagated") })},
+			verifier: PanicVerifier(`panic propagated`),
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actuals...).Will(AllOf(tc.matchers...)).Now()
+		})
+	}
+	t.Run("Panics without matchers", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(PanicVerifier(`expected at least one matcher`))
+		AllOf()
+	})
+}
@@ -0,0 +1,35 @@
+package justest
+
+import (
+	"strings"
+)
+
+//go:noinline
+func AnyOf(matchers ...Matcher) Matcher {
+	if len(matchers) == 0 {
+		panic("expected at least one matcher")
+	}
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+
+		var failures []string
+		for _, matcher := range matchers {
+			if failure := tryAssert(t, matcher, actuals...); failure == nil {
+				return
+			} else {
+				failures = append(failures, failure.String())
+			}
+		}
+
+		t.Fatalf("Expected at least one of %d matchers to match, but none did:%s", len(matchers), formatFailures(failures))
+	})
+
+	descriptions := make([]string, len(matchers))
+	negations := make([]string, len(matchers))
+	for i, matcher := range matchers {
+		descriptions[i] = describe(matcher)
+		negations[i] = describeNegation(matcher)
+	}
+	return Described(m, strings.Join(descriptions, " or "), strings.Join(negations, " and "))
+}
@@ -0,0 +1,58 @@
+package justest_test
+
+import (
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestAnyOf(t *testing.T) {
+	t.Parallel()
+	succeeding := MatcherFunc(func(t T, actuals ...any) {})
+	failing := func(msg string) MatcherFunc {
+		return func(t T, actuals ...any) { t.Fatalf("%s", msg); panic("unreachable") }
+	}
+	type testCase struct {
+		actuals  []any
+		matchers []Matcher
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Single success is enough": {
+			actuals:  []any{5},
+			matchers: []Matcher{failing("failure 1"), succeeding, failing("failure 2")},
+			verifier: SuccessVerifier(),
+		},
+		"Built-in matchers": {
+			actuals:  []any{"abc"},
+			matchers: []Matcher{BeEmpty(), Say("^abc$")},
+			verifier: SuccessVerifier(),
+		},
+		"All failures are reported when none match": {
+			actuals:  []any{5},
+			matchers: []Matcher{failing("failure 1"), failing("failure 2"), BeGreaterThan(10)},
+			verifier: FailureVerifier(`^Expected at least one of 3 matchers to match, but none did:\n\[1\] failure 1\n\[2\] failure 2\n\[3\] Expected actual value 5 to be greater than 10\n`),
+		},
+		"Panicking matcher re-panics": {
+			actuals:  []any{5},
+			matchers: []Matcher{failing("failure 1"), MatcherFunc(func(t T, actual ...any) { panic("panic propagated") })},
+			verifier: PanicVerifier(`panic propagated`),
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actuals...).Will(AnyOf(tc.matchers...)).Now()
+		})
+	}
+	t.Run("Panics without matchers", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(PanicVerifier(`expected at least one matcher`))
+		AnyOf()
+	})
+}
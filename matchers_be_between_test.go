@@ -1,10 +1,13 @@
 package justest_test
 
 import (
+	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 func TestBeBetween(t *testing.T) {
@@ -113,16 +116,41 @@ func TestBeBetween(t *testing.T) {
 			}
 		})
 	}
-	t.Run("MaxTypeMismatches", func(t *testing.T) {
+	t.Run("CrossKind", func(t *testing.T) {
+		t.Parallel()
+		type port uint16
+		crossKindTestCases := map[string]testCase{
+			"int64 actual with untyped bounds succeeds": {verifier: SuccessVerifier(), actual: int64(5), min: 1, max: 9},
+			"int64 actual with untyped bounds fails":    {verifier: FailureVerifier(`Expected actual value 10 to be between 1 and 9`), actual: int64(10), min: 1, max: 9},
+			"named unsigned actual succeeds":            {verifier: SuccessVerifier(), actual: port(8080), min: 1, max: 65535},
+			"duration actual succeeds":                  {verifier: SuccessVerifier(), actual: 5 * time.Second, min: time.Second, max: 10 * time.Second},
+			"duration actual fails":                     {verifier: FailureVerifier(`Expected actual value 1m0s to be between 1s and 10s`), actual: time.Minute, min: time.Second, max: 10 * time.Second},
+			"float actual with integer bounds":          {verifier: SuccessVerifier(), actual: 5.5, min: 5, max: uint8(6)},
+			"negative bound with unsigned actual":       {verifier: SuccessVerifier(), actual: uint64(math.MaxUint64), min: -1, max: uint64(math.MaxUint64)},
+			"unsigned actual above negative bounds":     {verifier: FailureVerifier(`Expected actual value 0 to be between -2 and -1`), actual: uint(0), min: int8(-2), max: int8(-1)},
+			"unsigned actual with negative minimum":     {verifier: SuccessVerifier(), actual: uint(0), min: int8(-1), max: 2},
+			"unsigned actual below signed bound":        {verifier: FailureVerifier(`Expected actual value 0 to be between 1 and 2`), actual: uint(0), min: int8(1), max: 2},
+		}
+		for name, tc := range crossKindTestCases {
+			tc := tc
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				mt := NewMockT(t)
+				defer mt.Verify(tc.verifier)
+				With(mt).VerifyThat(tc.actual).Will(BeBetween(tc.min, tc.max)).Now()
+			})
+		}
+	})
+	t.Run("NonNumericMinPanics", func(t *testing.T) {
 		t.Parallel()
 		mt := NewMockT(t)
-		defer mt.Verify(FailureVerifier(`Expected actual value to be of type 'int64', but it is of type 'int'`))
-		With(mt).VerifyThat(1).Will(BeBetween(0, int64(9))).Now()
+		defer mt.Verify(PanicVerifier(`expected a numeric minimum value, got: a \(string\)`))
+		BeBetween("a", 1)
 	})
-	t.Run("MinTypeMismatches", func(t *testing.T) {
+	t.Run("NaNActualFails", func(t *testing.T) {
 		t.Parallel()
 		mt := NewMockT(t)
-		defer mt.Verify(FailureVerifier(`Expected actual value to be of type 'int64', but it is of type 'int'`))
-		With(mt).VerifyThat(1).Will(BeBetween(int64(0), 9)).Now()
+		defer mt.Verify(FailureVerifier(`Value NaN cannot be compared, since it is NaN`))
+		With(mt).VerifyThat(math.NaN()).Will(BeBetween(0, 1)).Now()
 	})
 }
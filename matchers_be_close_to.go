@@ -0,0 +1,153 @@
+package justest
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+type toleranceMode int
+
+const (
+	absoluteTolerance toleranceMode = iota
+	relativeTolerance
+	ulpTolerance
+)
+
+type CloseToMatcher interface {
+	DescribedMatcher
+
+	// Relative makes the matcher interpret its epsilon as a fraction of the expected value (e.g. 0.01 for 1%), rather
+	// than as an absolute difference.
+	Relative() CloseToMatcher
+
+	// ULPs makes the matcher interpret its epsilon as the maximal number of units in the last place (ULPs) between the
+	// actual and expected values, rather than as an absolute difference.
+	ULPs() CloseToMatcher
+}
+
+type closeTo struct {
+	expected float64
+	epsilon  float64
+	mode     toleranceMode
+}
+
+//go:noinline
+func (m *closeTo) Assert(t T, actuals ...any) {
+	GetHelper(t).Helper()
+	for _, actual := range actuals {
+		v := NumericValueExtractor.MustExtractValue(t, actual)
+		f, _ := toFloat64(v)
+
+		if math.IsNaN(f) || math.IsNaN(m.expected) {
+			t.Fatalf("Expected actual value %v %s, but NaN is never close to any value (use BeNaN instead)", v, m.Describe())
+		} else if math.IsInf(f, 0) || math.IsInf(m.expected, 0) {
+			if f != m.expected {
+				t.Fatalf("Expected actual value %v %s, but it is not", v, m.Describe())
+			}
+			continue
+		}
+
+		switch m.mode {
+		case absoluteTolerance:
+			if diff := math.Abs(f - m.expected); diff > m.epsilon {
+				t.Fatalf("Expected actual value %v %s, but the difference is %v", v, m.Describe(), diff)
+			}
+		case relativeTolerance:
+			if diff := math.Abs(f - m.expected); diff > m.epsilon*math.Abs(m.expected) {
+				t.Fatalf("Expected actual value %v %s, but the difference is %v", v, m.Describe(), diff)
+			}
+		case ulpTolerance:
+			var distance uint64
+			if reflect.ValueOf(v).Kind() == reflect.Float32 {
+				distance = ulpDistance32(float32(f), float32(m.expected))
+			} else {
+				distance = ulpDistance64(f, m.expected)
+			}
+			if float64(distance) > m.epsilon {
+				t.Fatalf("Expected actual value %v %s, but it is %d ULPs away", v, m.Describe(), distance)
+			}
+		}
+	}
+}
+
+func (m *closeTo) Describe() string {
+	switch m.mode {
+	case relativeTolerance:
+		return fmt.Sprintf("to be within %v%% of %v", m.epsilon*100, m.expected)
+	case ulpTolerance:
+		return fmt.Sprintf("to be within %v ULPs of %v", m.epsilon, m.expected)
+	default:
+		return fmt.Sprintf("to be within %v of %v", m.epsilon, m.expected)
+	}
+}
+
+func (m *closeTo) DescribeNegation() string {
+	return "not " + m.Describe()
+}
+
+func (m *closeTo) Relative() CloseToMatcher {
+	m.mode = relativeTolerance
+	return m
+}
+
+func (m *closeTo) ULPs() CloseToMatcher {
+	m.mode = ulpTolerance
+	return m
+}
+
+// BeCloseTo returns a matcher that checks that all given numeric values are within the given epsilon of the expected
+// value. By default, the epsilon is an absolute difference; use CloseToMatcher.Relative or CloseToMatcher.ULPs to
+// interpret it as a relative difference or as a number of ULPs instead. NaN values are never close to any value, and
+// infinite values are only close to themselves.
+//
+//go:noinline
+func BeCloseTo(expected, epsilon any) CloseToMatcher {
+	expectedValue, ok := toFloat64(expected)
+	if !ok {
+		panic(fmt.Sprintf("expected a numeric expected value, got: %+v (%T)", expected, expected))
+	}
+
+	epsilonValue, ok := toFloat64(epsilon)
+	if !ok || math.IsNaN(epsilonValue) || epsilonValue < 0 {
+		panic(fmt.Sprintf("expected a non-negative numeric epsilon, got: %+v (%T)", epsilon, epsilon))
+	}
+
+	return &closeTo{expected: expectedValue, epsilon: epsilonValue, mode: absoluteTolerance}
+}
+
+// ulpDistance64 returns the number of representable float64 values between the given values.
+func ulpDistance64(a, b float64) uint64 {
+	ia, ib := orderedBits64(a), orderedBits64(b)
+	if ia > ib {
+		return uint64(ia) - uint64(ib)
+	}
+	return uint64(ib) - uint64(ia)
+}
+
+// orderedBits64 maps the given float64 to an int64 such that the order of the integers matches the order of floats.
+func orderedBits64(f float64) int64 {
+	bits := int64(math.Float64bits(f))
+	if bits < 0 {
+		bits = math.MinInt64 - bits
+	}
+	return bits
+}
+
+// ulpDistance32 returns the number of representable float32 values between the given values.
+func ulpDistance32(a, b float32) uint64 {
+	ia, ib := orderedBits32(a), orderedBits32(b)
+	if ia > ib {
+		return uint64(ia - ib)
+	}
+	return uint64(ib - ia)
+}
+
+// orderedBits32 maps the given float32 to an int64 such that the order of the integers matches the order of floats.
+func orderedBits32(f float32) int64 {
+	bits := int64(int32(math.Float32bits(f)))
+	if bits < 0 {
+		bits = math.MinInt32 - bits
+	}
+	return bits
+}
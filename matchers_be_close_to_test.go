@@ -0,0 +1,60 @@
+package justest_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestBeCloseTo(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		actual   any
+		matcher  func() Matcher
+		verifier TestOutcomeVerifier
+	}
+	//goland:noinspection GoRedundantConversion
+	testCases := map[string]testCase{
+		"Absolute within epsilon":       {actual: 0.1 + 0.2, matcher: func() Matcher { return BeCloseTo(0.3, 1e-9) }, verifier: SuccessVerifier()},
+		"Absolute on epsilon boundary":  {actual: 1.5, matcher: func() Matcher { return BeCloseTo(1, 0.5) }, verifier: SuccessVerifier()},
+		"Absolute beyond epsilon":       {actual: 1.6, matcher: func() Matcher { return BeCloseTo(1, 0.5) }, verifier: FailureVerifier(`^Expected actual value 1.6 to be within 0.5 of 1, but the difference is 0.6`)},
+		"Float32 actual":                {actual: float32(1.1), matcher: func() Matcher { return BeCloseTo(1.1, 1e-6) }, verifier: SuccessVerifier()},
+		"Integer actual":                {actual: 10, matcher: func() Matcher { return BeCloseTo(10.2, 0.5) }, verifier: SuccessVerifier()},
+		"Pointer actual":                {actual: func() any { f := 1.0; return &f }(), matcher: func() Matcher { return BeCloseTo(1, 0) }, verifier: SuccessVerifier()},
+		"Relative within tolerance":     {actual: 101.0, matcher: func() Matcher { return BeCloseTo(100, 0.01).Relative() }, verifier: SuccessVerifier()},
+		"Relative beyond tolerance":     {actual: 102.0, matcher: func() Matcher { return BeCloseTo(100, 0.01).Relative() }, verifier: FailureVerifier(`^Expected actual value 102 to be within 1% of 100, but the difference is 2`)},
+		"ULPs within tolerance":         {actual: math.Nextafter(1, 2), matcher: func() Matcher { return BeCloseTo(1, 1).ULPs() }, verifier: SuccessVerifier()},
+		"ULPs across zero":              {actual: -math.SmallestNonzeroFloat64, matcher: func() Matcher { return BeCloseTo(math.SmallestNonzeroFloat64, 2).ULPs() }, verifier: SuccessVerifier()},
+		"ULPs beyond tolerance":         {actual: math.Nextafter(math.Nextafter(1, 2), 2), matcher: func() Matcher { return BeCloseTo(1, 1).ULPs() }, verifier: FailureVerifier(`^Expected actual value 1.0000000000000004 to be within 1 ULPs of 1, but it is 2 ULPs away`)},
+		"Float32 ULPs":                  {actual: math.Nextafter32(1, 2), matcher: func() Matcher { return BeCloseTo(1, 1).ULPs() }, verifier: SuccessVerifier()},
+		"NaN actual":                    {actual: math.NaN(), matcher: func() Matcher { return BeCloseTo(1, 1) }, verifier: FailureVerifier(`^Expected actual value NaN to be within 1 of 1, but NaN is never close to any value \(use BeNaN instead\)`)},
+		"NaN expected":                  {actual: 1.0, matcher: func() Matcher { return BeCloseTo(math.NaN(), 1) }, verifier: FailureVerifier(`NaN is never close to any value`)},
+		"Same infinity":                 {actual: math.Inf(1), matcher: func() Matcher { return BeCloseTo(math.Inf(1), 0) }, verifier: SuccessVerifier()},
+		"Opposite infinity":             {actual: math.Inf(-1), matcher: func() Matcher { return BeCloseTo(math.Inf(1), math.MaxFloat64) }, verifier: FailureVerifier(`^Expected actual value -Inf to be within .+ of \+Inf, but it is not`)},
+		"Finite actual, infinite value": {actual: math.MaxFloat64, matcher: func() Matcher { return BeCloseTo(math.Inf(1), math.MaxFloat64) }, verifier: FailureVerifier(`but it is not`)},
+		"Non-numeric actual":            {actual: "1", matcher: func() Matcher { return BeCloseTo(1, 1) }, verifier: FailureVerifier(`^Unsupported actual value: 1`)},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(tc.matcher()).Now()
+		})
+	}
+	t.Run("Non-numeric expected value panics", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(PanicVerifier(`expected a numeric expected value, got: abc \(string\)`))
+		BeCloseTo("abc", 1)
+	})
+	t.Run("Negative epsilon panics", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(PanicVerifier(`expected a non-negative numeric epsilon, got: -1 \(int\)`))
+		BeCloseTo(1, -1)
+	})
+}
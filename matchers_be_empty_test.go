@@ -6,6 +6,7 @@ import (
 
 	. "github.com/arikkfir/justest"
 	. "github.com/arikkfir/justest/internal"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 func TestBeEmpty(t *testing.T) {
@@ -1,30 +1,25 @@
 package justest
 
 import (
-	"reflect"
+	"fmt"
 )
 
 //go:noinline
 func BeGreaterThan(min any) Matcher {
 	if min == nil {
 		panic("expected a non-nil minimum value")
+	} else if !isNumeric(min) {
+		panic(fmt.Sprintf("expected a numeric minimum value, got: %+v (%T)", min, min))
 	}
 
-	return MatcherFunc(func(t T, actuals ...any) {
+	m := MatcherFunc(func(t T, actuals ...any) {
 		GetHelper(t).Helper()
 		for _, actual := range actuals {
 			v := NumericValueExtractor.MustExtractValue(t, actual)
-			actualValue := reflect.ValueOf(v)
-
-			minimumValue := reflect.ValueOf(min)
-			if actualValue.Kind() != minimumValue.Kind() {
-				t.Fatalf("Expected actual value to be of type '%T', but it is of type '%T'", min, v)
-			}
-
-			resultValues := getNumericCompareFuncFor(t, v).Call([]reflect.Value{actualValue, minimumValue})
-			if resultValues[0].Int() <= 0 {
+			if compareNumbers(t, v, min) <= 0 {
 				t.Fatalf("Expected actual value %v to be greater than %v", v, min)
 			}
 		}
 	})
+	return Described(m, fmt.Sprintf("to be greater than %v", min), fmt.Sprintf("not to be greater than %v", min))
 }
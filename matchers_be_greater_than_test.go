@@ -3,8 +3,10 @@ package justest_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 func TestBeGreaterThan(t *testing.T) {
@@ -74,6 +76,11 @@ func TestBeGreaterThan(t *testing.T) {
 			"AboveMin succeeds": {verifier: SuccessVerifier(), actual: uint64(5), min: uint64(0)},
 			"BelowMin fails":    {verifier: FailureVerifier(`Expected actual value 5 to be greater than 6`), actual: uint64(5), min: uint64(6)},
 		},
+		reflect.Uintptr: {
+			"EqualMin fails":    {verifier: FailureVerifier(`Expected actual value 5 to be greater than 5`), actual: uintptr(5), min: uintptr(5)},
+			"AboveMin succeeds": {verifier: SuccessVerifier(), actual: uintptr(5), min: uintptr(0)},
+			"BelowMin fails":    {verifier: FailureVerifier(`Expected actual value 5 to be greater than 6`), actual: uintptr(5), min: uintptr(6)},
+		},
 	}
 	for kind, kindTestCases := range testCases {
 		kind := kind
@@ -91,10 +98,23 @@ func TestBeGreaterThan(t *testing.T) {
 			}
 		})
 	}
-	t.Run("MinTypeMismatches", func(t *testing.T) {
+	t.Run("CrossKind", func(t *testing.T) {
 		t.Parallel()
-		mt := NewMockT(t)
-		defer mt.Verify(FailureVerifier(`Expected actual value to be of type 'int64', but it is of type 'int'`))
-		With(mt).VerifyThat(1).Will(BeGreaterThan(int64(0))).Now()
+		crossKindTestCases := map[string]testCase{
+			"int actual with int64 minimum succeeds":  {verifier: SuccessVerifier(), actual: 1, min: int64(0)},
+			"unsigned actual with negative minimum":   {verifier: SuccessVerifier(), actual: uint8(0), min: -1},
+			"negative actual with unsigned minimum":   {verifier: FailureVerifier(`Expected actual value -1 to be greater than 0`), actual: -1, min: uint64(0)},
+			"large int64 actual with float64 minimum": {verifier: SuccessVerifier(), actual: int64(1<<53 + 1), min: float64(1 << 53)},
+			"duration actual with duration minimum":   {verifier: SuccessVerifier(), actual: 2 * time.Second, min: time.Second},
+		}
+		for name, tc := range crossKindTestCases {
+			tc := tc
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				mt := NewMockT(t)
+				defer mt.Verify(tc.verifier)
+				With(mt).VerifyThat(tc.actual).Will(BeGreaterThan(tc.min)).Now()
+			})
+		}
 	})
 }
@@ -0,0 +1,34 @@
+package justest_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestBeInf(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		actual   any
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Positive infinity": {actual: math.Inf(1), verifier: SuccessVerifier()},
+		"Negative infinity": {actual: math.Inf(-1), verifier: SuccessVerifier()},
+		"Float32 infinity":  {actual: float32(math.Inf(1)), verifier: SuccessVerifier()},
+		"Finite float":      {actual: math.MaxFloat64, verifier: FailureVerifier(`^Expected actual value 1.7976931348623157e\+308 to be infinite, but it is not`)},
+		"NaN":               {actual: math.NaN(), verifier: FailureVerifier(`^Expected actual value NaN to be infinite, but it is not`)},
+		"Integer":           {actual: math.MaxInt64, verifier: FailureVerifier(`^Expected actual value 9223372036854775807 to be infinite, but it is not`)},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(BeInf()).Now()
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package justest_test
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
 	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 func TestBeLessThan(t *testing.T) {
@@ -91,10 +93,22 @@ func TestBeLessThan(t *testing.T) {
 			}
 		})
 	}
-	t.Run("MinTypeMismatches", func(t *testing.T) {
+	t.Run("CrossKind", func(t *testing.T) {
 		t.Parallel()
-		mt := NewMockT(t)
-		defer mt.Verify(FailureVerifier(`Expected actual value to be of type 'int64', but it is of type 'int'`))
-		With(mt).VerifyThat(1).Will(BeLessThan(int64(0))).Now()
+		crossKindTestCases := map[string]testCase{
+			"int actual with int64 maximum fails":       {verifier: FailureVerifier(`Expected actual value 1 to be less than 0`), actual: 1, max: int64(0)},
+			"negative actual with unsigned maximum":     {verifier: SuccessVerifier(), actual: int64(math.MinInt64), max: uint64(0)},
+			"max unsigned actual with negative maximum": {verifier: FailureVerifier(`Expected actual value 18446744073709551615 to be less than -1`), actual: uint64(math.MaxUint64), max: -1},
+			"float32 actual with float64 maximum":       {verifier: SuccessVerifier(), actual: float32(1.5), max: 1.6},
+		}
+		for name, tc := range crossKindTestCases {
+			tc := tc
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				mt := NewMockT(t)
+				defer mt.Verify(tc.verifier)
+				With(mt).VerifyThat(tc.actual).Will(BeLessThan(tc.max)).Now()
+			})
+		}
 	})
 }
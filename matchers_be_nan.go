@@ -0,0 +1,19 @@
+package justest
+
+import (
+	"math"
+)
+
+//go:noinline
+func BeNaN() Matcher {
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			v := NumericValueExtractor.MustExtractValue(t, actual)
+			if f, _ := toFloat64(v); !math.IsNaN(f) {
+				t.Fatalf("Expected actual value %v to be NaN, but it is not", v)
+			}
+		}
+	})
+	return Described(m, "to be NaN", "not to be NaN")
+}
@@ -0,0 +1,34 @@
+package justest_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestBeNaN(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		actual   any
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"NaN float64":   {actual: math.NaN(), verifier: SuccessVerifier()},
+		"NaN float32":   {actual: float32(math.NaN()), verifier: SuccessVerifier()},
+		"Finite float":  {actual: 1.5, verifier: FailureVerifier(`^Expected actual value 1.5 to be NaN, but it is not`)},
+		"Infinite":      {actual: math.Inf(1), verifier: FailureVerifier(`^Expected actual value \+Inf to be NaN, but it is not`)},
+		"Integer":       {actual: 1, verifier: FailureVerifier(`^Expected actual value 1 to be NaN, but it is not`)},
+		"Non-numerical": {actual: "NaN", verifier: FailureVerifier(`^Unsupported actual value: NaN`)},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(BeNaN()).Now()
+		})
+	}
+}
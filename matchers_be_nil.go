@@ -10,7 +10,7 @@ var (
 
 //go:noinline
 func BeNil() Matcher {
-	return MatcherFunc(func(t T, actuals ...any) {
+	m := MatcherFunc(func(t T, actuals ...any) {
 		GetHelper(t).Helper()
 		for _, actual := range actuals {
 			v := nilValueExtractor.MustExtractValue(t, actual)
@@ -26,4 +26,5 @@ func BeNil() Matcher {
 			}
 		}
 	})
+	return Described(m, "to be nil", "not to be nil")
 }
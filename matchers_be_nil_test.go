@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 func TestBeNil(t *testing.T) {
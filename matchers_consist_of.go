@@ -0,0 +1,41 @@
+package justest
+
+import (
+	"strings"
+)
+
+// ConsistOf returns a matcher that checks that all given collections consist of exactly the given elements (values or
+// matchers), in any order.
+//
+// Collections can be arrays, slices, maps (whose values are the elements), strings (whose runes are the elements), or
+// channels. Channel elements are the values currently buffered in the channel, which are received (and thus removed
+// from the channel) by the matcher; since each attempt of a timed assertion (e.g. Within) receives whatever is buffered
+// at that time, prefer collecting channel values into a slice when polling.
+//
+//go:noinline
+func ConsistOf(expected ...any) Matcher {
+	descriptions := make([]string, len(expected))
+	for i, e := range expected {
+		descriptions[i] = describeElement(e)
+	}
+	description := "to consist of " + strings.Join(descriptions, ", ")
+	if len(expected) == 0 {
+		description = "to consist of no elements"
+	}
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			v := collectionValueExtractor.MustExtractValue(t, actual)
+			missing, extra := matchElements(t, expected, elementsOf(t, v))
+			if len(missing) > 0 && len(extra) > 0 {
+				t.Fatalf("Expected '%+v' %s, but it is missing %s and has extra %s", v, description, describeValues(missing), describeValues(extra))
+			} else if len(missing) > 0 {
+				t.Fatalf("Expected '%+v' %s, but it is missing %s", v, description, describeValues(missing))
+			} else if len(extra) > 0 {
+				t.Fatalf("Expected '%+v' %s, but it has extra %s", v, description, describeValues(extra))
+			}
+		}
+	})
+	return Described(m, description, "not "+description)
+}
@@ -0,0 +1,42 @@
+package justest_test
+
+import (
+	"regexp"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/internal"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestConsistOf(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		actual   any
+		expected []any
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Same order":               {actual: []int{1, 2, 3}, expected: []any{1, 2, 3}, verifier: SuccessVerifier()},
+		"Different order":          {actual: []int{1, 2, 3}, expected: []any{3, 1, 2}, verifier: SuccessVerifier()},
+		"Empty":                    {actual: []int{}, expected: nil, verifier: SuccessVerifier()},
+		"Chan":                     {actual: ChanOf(1, 2), expected: []any{2, 1}, verifier: SuccessVerifier()},
+		"Map values":               {actual: map[string]int{"a": 1, "b": 2}, expected: []any{2, 1}, verifier: SuccessVerifier()},
+		"String runes":             {actual: "ab", expected: []any{'b', 'a'}, verifier: SuccessVerifier()},
+		"Nested matchers":          {actual: []int{7, 1}, expected: []any{BeLessThan(5), BeGreaterThan(5)}, verifier: SuccessVerifier()},
+		"Overlapping matchers":     {actual: []int{1, 3}, expected: []any{BeLessThan(5), 1}, verifier: SuccessVerifier()},
+		"Missing elements":         {actual: []int{1, 2}, expected: []any{1, 2, 3}, verifier: FailureVerifier(regexp.QuoteMeta(`Expected '[1 2]' to consist of element 1, element 2, element 3, but it is missing 3`))},
+		"Extra elements":           {actual: []int{1, 2, 3, 4}, expected: []any{1, 2}, verifier: FailureVerifier(regexp.QuoteMeta(`Expected '[1 2 3 4]' to consist of element 1, element 2, but it has extra [3, 4]`))},
+		"Missing & extra elements": {actual: []int{1, 2, 4}, expected: []any{1, 3}, verifier: FailureVerifier(regexp.QuoteMeta(`Expected '[1 2 4]' to consist of element 1, element 3, but it is missing 3 and has extra [2, 4]`))},
+		"Duplicates":               {actual: []int{1, 1}, expected: []any{1}, verifier: FailureVerifier(`but it has extra 1\n`)},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(ConsistOf(tc.expected...)).Now()
+		})
+	}
+}
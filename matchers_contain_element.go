@@ -0,0 +1,58 @@
+package justest
+
+import (
+	"strings"
+)
+
+// ContainElement returns a matcher that checks that all given collections contain an element matching the given
+// expected element (a value or a matcher). Note that channel elements are received, and thus removed from the
+// channel, by the matcher (see ConsistOf).
+//
+//go:noinline
+func ContainElement(expected any) Matcher {
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			v := collectionValueExtractor.MustExtractValue(t, actual)
+			found := false
+			for _, element := range elementsOf(t, v) {
+				if elementMatches(t, expected, element) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Fatalf("Expected '%+v' to contain %s, but it does not", v, describeElement(expected))
+			}
+		}
+	})
+	return Described(m, "to contain "+describeElement(expected), "not to contain "+describeElement(expected))
+}
+
+// ContainElements returns a matcher that checks that all given collections contain elements matching all the given
+// expected elements (values or matchers), in any order. Like ContainElement, it removes the elements it receives from
+// channels.
+//
+//go:noinline
+func ContainElements(expected ...any) Matcher {
+	if len(expected) == 0 {
+		panic("expected at least one element")
+	}
+
+	descriptions := make([]string, len(expected))
+	for i, e := range expected {
+		descriptions[i] = describeElement(e)
+	}
+	description := strings.Join(descriptions, ", ")
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			v := collectionValueExtractor.MustExtractValue(t, actual)
+			if missing, _ := matchElements(t, expected, elementsOf(t, v)); len(missing) > 0 {
+				t.Fatalf("Expected '%+v' to contain %s, but it is missing: %s", v, description, describeValues(missing))
+			}
+		}
+	})
+	return Described(m, "to contain "+description, "not to contain "+description)
+}
@@ -0,0 +1,67 @@
+package justest_test
+
+import (
+	"regexp"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/internal"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestContainElement(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		actual   any
+		expected any
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Array contains":           {actual: [3]int{1, 2, 3}, expected: 2, verifier: SuccessVerifier()},
+		"Array does not contain":   {actual: [3]int{1, 2, 3}, expected: 4, verifier: FailureVerifier(regexp.QuoteMeta(`Expected '[1 2 3]' to contain element 4, but it does not`))},
+		"Chan contains":            {actual: ChanOf("a", "b"), expected: "b", verifier: SuccessVerifier()},
+		"Map contains value":       {actual: map[string]int{"a": 1}, expected: 1, verifier: SuccessVerifier()},
+		"Map does not contain key": {actual: map[string]int{"a": 1}, expected: "a", verifier: FailureVerifier(regexp.QuoteMeta(`Expected 'map[a:1]' to contain element "a", but it does not`))},
+		"Slice contains":           {actual: []string{"a", "b"}, expected: "b", verifier: SuccessVerifier()},
+		"Slice of structs":         {actual: []struct{ A int }{{A: 1}, {A: 2}}, expected: struct{ A int }{A: 2}, verifier: SuccessVerifier()},
+		"String contains rune":     {actual: "abc", expected: 'b', verifier: SuccessVerifier()},
+		"Nested matcher matches":   {actual: []int{1, 6}, expected: BeGreaterThan(5), verifier: SuccessVerifier()},
+		"Nested matcher fails":     {actual: []int{1, 2}, expected: BeGreaterThan(5), verifier: FailureVerifier(regexp.QuoteMeta(`Expected '[1 2]' to contain an element expected to be greater than 5, but it does not`))},
+		"Pointer to slice":         {actual: Ptr([]int{1, 2}), expected: 2, verifier: SuccessVerifier()},
+		"Unsupported type fails":   {actual: 1, expected: 1, verifier: FailureVerifier(`Unsupported actual value: 1`)},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(ContainElement(tc.expected)).Now()
+		})
+	}
+}
+
+func TestContainElements(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		actual   any
+		expected []any
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Contains all in any order":   {actual: []int{1, 2, 3}, expected: []any{3, 1}, verifier: SuccessVerifier()},
+		"Missing elements reported":   {actual: []int{1, 2, 3}, expected: []any{3, 4, 5}, verifier: FailureVerifier(regexp.QuoteMeta(`Expected '[1 2 3]' to contain element 3, element 4, element 5, but it is missing: [4, 5]`))},
+		"Duplicates must be distinct": {actual: []int{1, 2}, expected: []any{1, 1}, verifier: FailureVerifier(`but it is missing: 1\n`)},
+		"Nested matchers":             {actual: []int{1, 7}, expected: []any{BeLessThan(5), BeGreaterThan(5)}, verifier: SuccessVerifier()},
+		"Overlapping matchers":        {actual: []int{3, 1}, expected: []any{BeLessThan(5), 3}, verifier: SuccessVerifier()},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(ContainElements(tc.expected...)).Now()
+		})
+	}
+}
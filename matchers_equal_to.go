@@ -9,7 +9,7 @@ import (
 type Comparator func(t T, expected any, actual any)
 
 type EqualToMatcher interface {
-	Matcher
+	DescribedMatcher
 	Using(comparator Comparator) EqualToMatcher
 }
 
@@ -32,6 +32,14 @@ func (m *equalTo) Assert(t T, actuals ...any) {
 	}
 }
 
+func (m *equalTo) Describe() string {
+	return "to equal " + describeValues(m.expected)
+}
+
+func (m *equalTo) DescribeNegation() string {
+	return "not to equal " + describeValues(m.expected)
+}
+
 func (m *equalTo) Using(comparator Comparator) EqualToMatcher {
 	m.comparator = comparator
 	return m
@@ -1,44 +1,67 @@
 package justest
 
 import (
-	"reflect"
-	"regexp"
+	"fmt"
+	"strings"
 )
 
+// Fail returns a matcher that checks the last actual value is a non-nil error. If patterns are given, the error message
+// must match at least one of them; use FailWith for other kinds of expectations.
+//
 //go:noinline
 func Fail(patterns ...string) Matcher {
-	const failureFormatMsg = `Error message did not match any pattern:
-	Patterns: %v
-	Error:    %s`
-	return MatcherFunc(func(t T, actuals ...any) {
-		GetHelper(t).Helper()
+	expectations := make([]any, len(patterns))
+	for i, pattern := range patterns {
+		expectations[i] = pattern
+	}
+	return FailWith(expectations...)
+}
 
-		l := len(actuals)
-		if l == 0 {
-			t.Fatalf("No error occurred")
+// FailWith returns a matcher that checks the last actual value is a non-nil error. If expectations are given, the error
+// must satisfy at least one of them; see MatchError for the supported expectation forms.
+//
+//go:noinline
+func FailWith(expectations ...any) Matcher {
+	const failureFormatMsg = `Error did not match any expectation:
+	Expectations: %v
+	Error:        %s`
+
+	compiledExpectations := make([]errorExpectation, len(expectations))
+	descriptions := make([]string, len(expectations))
+	for i, expectation := range expectations {
+		compiledExpectations[i] = newErrorExpectation(expectation)
+		if s, ok := expectation.(string); ok {
+			descriptions[i] = s
+		} else {
+			descriptions[i] = compiledExpectations[i].description
 		}
+	}
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
 
-		last := actuals[l-1]
-		if last == nil {
-			t.Fatalf("No error occurred")
+		err := lastError(t, actuals)
+		if len(compiledExpectations) == 0 {
+			return
 		}
 
-		lastRT := reflect.TypeOf(last)
-		if lastRT.AssignableTo(reflect.TypeOf((*error)(nil)).Elem()) {
-			if len(patterns) > 0 {
-				msg := last.(error).Error()
-				for _, pattern := range patterns {
-					re := regexp.MustCompile(pattern)
-					if re.MatchString(msg) {
-						return
-					}
-				}
-				t.Fatalf(failureFormatMsg, patterns, msg)
-			} else {
+		var failures []string
+		for _, expectation := range compiledExpectations {
+			if _, failure := expectation.verify(t, err); failure == "" {
 				return
+			} else {
+				failures = append(failures, failure)
 			}
 		}
-
-		t.Fatalf("No error occurred")
+		if len(failures) == 1 {
+			t.Fatalf("%s", failures[0])
+		}
+		t.Fatalf(failureFormatMsg, descriptions, err)
 	})
+	if len(expectations) == 0 {
+		return Described(m, "to fail", "not to fail")
+	}
+	return Described(m,
+		fmt.Sprintf("to fail with an error matching one of [%s]", strings.Join(descriptions, ", ")),
+		fmt.Sprintf("not to fail with an error matching any of [%s]", strings.Join(descriptions, ", ")))
 }
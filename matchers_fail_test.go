@@ -2,10 +2,12 @@ package justest_test
 
 import (
 	"fmt"
+	"io/fs"
 	"regexp"
 	"testing"
 
 	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 func TestFail(t *testing.T) {
@@ -53,4 +55,36 @@ func TestFail(t *testing.T) {
 		defer mt.Verify(FailureVerifier(`.*` + regexp.QuoteMeta(`[^abc$ ^def$ ^ghi$]`) + `\n.*expected error`))
 		With(mt).VerifyThat(fmt.Errorf("expected error")).Will(Fail(`^abc$`, `^def$`, `^ghi$`)).Now()
 	})
+	t.Run("Accepts a spread slice of patterns", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		patterns := []string{`^abc$`, `^expected error$`}
+		With(mt).VerifyThat(fmt.Errorf("expected error")).Will(Fail(patterns...)).Now()
+	})
+	t.Run("Succeeds if error matches a sentinel error", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		With(mt).VerifyThat(fmt.Errorf("wrapped: %w", fs.ErrNotExist)).Will(FailWith(fs.ErrExist, fs.ErrNotExist)).Now()
+	})
+	t.Run("Succeeds if error is assignable to an error type", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		var pathError *fs.PathError
+		With(mt).VerifyThat(&fs.PathError{Op: "open", Path: "/foo", Err: fs.ErrNotExist}).Will(FailWith(&pathError)).Now()
+	})
+	t.Run("Succeeds if error message matches a matcher", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		With(mt).VerifyThat(fmt.Errorf("expected error")).Will(FailWith(EqualTo("expected error"))).Now()
+	})
+	t.Run("Single expectation failure is reported directly", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^Expected error 'expected error' to match 'file does not exist' \(using errors\.Is\), but it does not`))
+		With(mt).VerifyThat(fmt.Errorf("expected error")).Will(FailWith(fs.ErrNotExist)).Now()
+	})
 }
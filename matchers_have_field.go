@@ -0,0 +1,147 @@
+package justest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	structValueExtractor ValueExtractor
+)
+
+func init() {
+	structValueExtractor = NewValueExtractor(ExtractSameValue)
+	structValueExtractor[reflect.Chan] = NewChannelExtractor(structValueExtractor, true)
+	structValueExtractor[reflect.Func] = NewFuncExtractor(structValueExtractor, true)
+}
+
+// resolveField walks the given dotted field path (e.g. "Spec.Replicas") from the given value, dereferencing pointers
+// and interfaces along the way; segments ending with "()" (e.g. "Spec.Name()") call the method of that name, which
+// must take no arguments and return a single value. It returns the resolved value, or a description of why the path
+// could not be resolved.
+func resolveField(v any, path string) (any, string) {
+	current := reflect.ValueOf(v)
+	walked := ""
+	for _, segment := range strings.Split(path, ".") {
+		parent := walked
+		if walked == "" {
+			walked = segment
+		} else {
+			walked += "." + segment
+		}
+		if parent == "" {
+			parent = "value"
+		} else {
+			parent = "'" + parent + "'"
+		}
+
+		if !current.IsValid() {
+			return nil, fmt.Sprintf("%s is nil", parent)
+		}
+
+		if name, isMethod := strings.CutSuffix(segment, "()"); isMethod {
+			method := current.MethodByName(name)
+			for !method.IsValid() && (current.Kind() == reflect.Pointer || current.Kind() == reflect.Interface) && !current.IsNil() {
+				current = current.Elem()
+				method = current.MethodByName(name)
+			}
+			if !method.IsValid() {
+				return nil, fmt.Sprintf("%s of type '%s' has no method '%s'", parent, current.Type(), name)
+			} else if method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+				return nil, fmt.Sprintf("method '%s' must take no arguments and return a single value", walked)
+			}
+			current = method.Call(nil)[0]
+			continue
+		}
+
+		for current.Kind() == reflect.Pointer || current.Kind() == reflect.Interface {
+			if current.IsNil() {
+				return nil, fmt.Sprintf("%s is nil", parent)
+			}
+			current = current.Elem()
+		}
+		if current.Kind() != reflect.Struct {
+			return nil, fmt.Sprintf("%s of type '%s' is not a struct", parent, current.Type())
+		}
+		field, ok := current.Type().FieldByName(segment)
+		if !ok || !field.IsExported() {
+			return nil, fmt.Sprintf("%s of type '%s' has no exported field '%s'", parent, current.Type(), segment)
+		}
+		value, nilEmbedded := fieldByIndex(current, field.Index)
+		if nilEmbedded != "" {
+			return nil, fmt.Sprintf("%s of type '%s' has nil embedded field '%s', through which field '%s' is promoted", parent, current.Type(), nilEmbedded, segment)
+		}
+		current = value
+	}
+	if !current.IsValid() {
+		return nil, ""
+	}
+	return current.Interface(), ""
+}
+
+// fieldByIndex returns the (possibly promoted) field of the given struct value at the given index sequence, or the
+// name of the nil embedded struct pointer through which the field is promoted.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, string) {
+	if field, err := v.FieldByIndexErr(index); err == nil {
+		return field, ""
+	}
+
+	var names []string
+	for _, i := range index[:len(index)-1] {
+		if v.Kind() == reflect.Pointer {
+			v = v.Elem()
+		}
+		names = append(names, v.Type().Field(i).Name)
+		v = v.Field(i)
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			break
+		}
+	}
+	return reflect.Value{}, strings.Join(names, ".")
+}
+
+// verifyFieldValue checks the given field value against the given expectation, which is either a Matcher or a value
+// compared using go-cmp, and returns a description of the mismatch, or an empty string if it matches.
+//
+//go:noinline
+func verifyFieldValue(t T, expected, value any) string {
+	GetHelper(t).Helper()
+	if m, ok := expected.(Matcher); ok {
+		if failure := tryAssert(t, m, value); failure != nil {
+			return failure.String()
+		}
+		return ""
+	} else if !cmp.Equal(expected, value) {
+		return fmt.Sprintf("expected %s, got %s", describeValue(expected), describeValue(value))
+	}
+	return ""
+}
+
+// HaveField returns a matcher that checks that the field at the given dotted path (e.g. "Spec.Replicas") of all given
+// actual values matches the given expectation, which is either a Matcher or a value. The path walks exported fields,
+// dereferencing pointers along the way; segments ending with "()" (e.g. "Spec.Name()") call the method of that name.
+//
+//go:noinline
+func HaveField(path string, expected any) Matcher {
+	if path == "" {
+		panic("expected a non-empty field path")
+	}
+
+	description := fmt.Sprintf("field '%s' with %s", path, describeValueExpectation(expected))
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			v := structValueExtractor.MustExtractValue(t, actual)
+			value, missing := resolveField(v, path)
+			if missing != "" {
+				t.Fatalf("Expected '%+v' to have field '%s', but %s", v, path, missing)
+			} else if failure := verifyFieldValue(t, expected, value); failure != "" {
+				t.Fatalf("Expected field '%s' to match, but it did not: %s", path, failure)
+			}
+		}
+	})
+	return Described(m, "to have "+description, "not to have "+description)
+}
@@ -0,0 +1,127 @@
+package justest_test
+
+import (
+	"regexp"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+type fieldsSpec struct {
+	Replicas int
+	Labels   map[string]string
+	Parent   *fieldsSpec
+}
+
+type fieldsObject struct {
+	Name   string
+	Spec   *fieldsSpec
+	Port   int
+	hidden string
+}
+
+// FieldsBase is embedded by pointer in fieldsDerived, promoting its fields.
+type FieldsBase struct {
+	Name string
+}
+
+type fieldsDerived struct {
+	*FieldsBase
+	Port int
+}
+
+func (o fieldsObject) DisplayName() string { return "svc-" + o.Name }
+
+func (o *fieldsObject) Replicas() int { return o.Spec.Replicas }
+
+func (o fieldsObject) Invalid(int) string { return "" }
+
+func TestHaveField(t *testing.T) {
+	t.Parallel()
+	obj := &fieldsObject{Name: "web", Spec: &fieldsSpec{Replicas: 3, Labels: map[string]string{"app": "web"}}, hidden: "x"}
+	type testCase struct {
+		actual   any
+		path     string
+		expected any
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Field value":                  {actual: obj, path: "Name", expected: "web", verifier: SuccessVerifier()},
+		"Nested field through pointer": {actual: obj, path: "Spec.Replicas", expected: EqualTo(3), verifier: SuccessVerifier()},
+		"Struct value actual":          {actual: *obj, path: "Spec.Labels", expected: HaveKeyWithValue("app", "web"), verifier: SuccessVerifier()},
+		"Value receiver method":        {actual: *obj, path: "DisplayName()", expected: Say("^svc-"), verifier: SuccessVerifier()},
+		"Pointer receiver method":      {actual: obj, path: "Replicas()", expected: 3, verifier: SuccessVerifier()},
+		"Function actual":              {actual: func() *fieldsObject { return obj }, path: "Name", expected: "web", verifier: SuccessVerifier()},
+		"Nil field":                    {actual: obj, path: "Spec.Parent", expected: BeNil(), verifier: SuccessVerifier()},
+		"Mismatching value": {
+			actual:   obj,
+			path:     "Spec.Replicas",
+			expected: 2,
+			verifier: FailureVerifier(regexp.QuoteMeta(`Expected field 'Spec.Replicas' to match, but it did not: expected 2, got 3`)),
+		},
+		"Mismatching matcher": {
+			actual:   obj,
+			path:     "Spec.Replicas",
+			expected: BeGreaterThan(5),
+			verifier: FailureVerifier(regexp.QuoteMeta(`Expected field 'Spec.Replicas' to match, but it did not: `)),
+		},
+		"Missing field": {
+			actual:   obj,
+			path:     "Spec.Missing",
+			expected: 1,
+			verifier: FailureVerifier(regexp.QuoteMeta(`to have field 'Spec.Missing', but 'Spec' of type 'justest_test.fieldsSpec' has no exported field 'Missing'`)),
+		},
+		"Unexported field": {
+			actual:   obj,
+			path:     "hidden",
+			expected: "x",
+			verifier: FailureVerifier(regexp.QuoteMeta(`to have field 'hidden', but value of type 'justest_test.fieldsObject' has no exported field 'hidden'`)),
+		},
+		"Nil pointer along the path": {
+			actual:   obj,
+			path:     "Spec.Parent.Replicas",
+			expected: 1,
+			verifier: FailureVerifier(regexp.QuoteMeta(`to have field 'Spec.Parent.Replicas', but 'Spec.Parent' is nil`)),
+		},
+		"Promoted field": {
+			actual:   fieldsDerived{FieldsBase: &FieldsBase{Name: "base"}},
+			path:     "Name",
+			expected: "base",
+			verifier: SuccessVerifier(),
+		},
+		"Promoted field through nil embedded pointer": {
+			actual:   fieldsDerived{Port: 80},
+			path:     "Name",
+			expected: "base",
+			verifier: FailureVerifier(regexp.QuoteMeta(`to have field 'Name', but value of type 'justest_test.fieldsDerived' has nil embedded field 'FieldsBase', through which field 'Name' is promoted`)),
+		},
+		"Not a struct": {
+			actual:   obj,
+			path:     "Name.Length",
+			expected: 1,
+			verifier: FailureVerifier(regexp.QuoteMeta(`to have field 'Name.Length', but 'Name' of type 'string' is not a struct`)),
+		},
+		"Missing method": {
+			actual:   obj,
+			path:     "Missing()",
+			expected: 1,
+			verifier: FailureVerifier(regexp.QuoteMeta(`to have field 'Missing()', but value of type 'justest_test.fieldsObject' has no method 'Missing'`)),
+		},
+		"Method with arguments": {
+			actual:   obj,
+			path:     "Invalid()",
+			expected: "",
+			verifier: FailureVerifier(regexp.QuoteMeta(`to have field 'Invalid()', but method 'Invalid()' must take no arguments and return a single value`)),
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(HaveField(tc.path, tc.expected)).Now()
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package justest
+
+import (
+	"fmt"
+)
+
+// HaveHTTPBody returns a matcher that checks that the body of all given HTTP responses (an *http.Response or an
+// *httptest.ResponseRecorder) matches the given expectation, which is either a Matcher (e.g. MatchJSON(...)), given the
+// body as a string, or a string or []byte that must equal the body. The body is restored after being read, so that
+// multiple matchers (as well as the test itself) can read it.
+//
+//go:noinline
+func HaveHTTPBody(expected any) Matcher {
+	switch e := expected.(type) {
+	case Matcher, string:
+	case []byte:
+		expected = string(e)
+	default:
+		panic(fmt.Sprintf("unsupported expected value for HaveHTTPBody matcher: %T", expected))
+	}
+
+	description := "HTTP body with " + describeValueExpectation(expected)
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			resp := extractHTTPResponse(t, "HaveHTTPBody", actual)
+			body, err := readHTTPBody(resp)
+			if err != nil {
+				t.Fatalf("Failed reading HTTP body: %+v", err)
+			} else if failure := verifyFieldValue(t, expected, string(body)); failure != "" {
+				t.Fatalf("Expected HTTP body to match, but it does not: %s\n%s", failure, describeHTTPResponse(resp))
+			}
+		}
+	})
+	return Described(m, "to have "+description, "not to have "+description)
+}
@@ -0,0 +1,62 @@
+package justest_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestHaveHTTPBody(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		expected any
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Equal string":     {expected: `{"id": 1, "tags": ["a"]}`, verifier: SuccessVerifier()},
+		"Equal bytes":      {expected: []byte(`{"id": 1, "tags": ["a"]}`), verifier: SuccessVerifier()},
+		"Matching matcher": {expected: MatchJSON(`{"tags":["a"],"id":1}`), verifier: SuccessVerifier()},
+		"Different string": {expected: `{}`, verifier: FailureVerifier(`Expected HTTP body to match, but it does not: expected "{}", got "{\\"id\\": 1, \\"tags\\": \[\\"a\\"\]}"\nHTTP/1.1 200 OK\n`)},
+		"Mismatching matcher": {
+			expected: MatchJSON(`{"id":2,"tags":["a"]}`),
+			verifier: FailureVerifier(`Expected HTTP body to match, but it does not: Expected JSON to match .+, but it differs:\n\$\.id: expected 2, got 1\n.*\n\n\{"id": 1, "tags": \["a"\]\}`),
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(newHTTPRecorder(http.StatusOK, nil, `{"id": 1, "tags": ["a"]}`)).Will(HaveHTTPBody(tc.expected)).Now()
+		})
+	}
+	t.Run("Unsupported expected value", func(t *testing.T) {
+		t.Parallel()
+		With(t).VerifyThat(func() { HaveHTTPBody(1) }).Will(PanicWith(EqualTo("unsupported expected value for HaveHTTPBody matcher: int"))).Now()
+	})
+}
+
+func TestHaveHTTPBodyRestoresBody(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	With(t).VerifyThat(err).Will(BeNil()).Now()
+	defer resp.Body.Close()
+	With(t).VerifyThat(resp).Will(AllOf(
+		HaveHTTPStatus(http.StatusOK),
+		HaveHTTPHeaderWithValue("Content-Type", Say("json")),
+		HaveHTTPBody(MatchJSON(`{"status":"ok"}`)),
+		HaveHTTPBody(Say("ok")),
+	)).Now()
+	With(t).VerifyThat(io.ReadAll(resp.Body)).Will(EqualTo([]byte(`{"status":"ok"}`), nil)).Now()
+}
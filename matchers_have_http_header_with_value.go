@@ -0,0 +1,30 @@
+package justest
+
+import (
+	"fmt"
+)
+
+// HaveHTTPHeaderWithValue returns a matcher that checks that all given HTTP responses (an *http.Response or an
+// *httptest.ResponseRecorder) have the given header, whose (first) value matches the given expectation, which is either
+// a Matcher (e.g. Say("json")) or a string.
+//
+//go:noinline
+func HaveHTTPHeaderWithValue(name string, expected any) Matcher {
+	if name == "" {
+		panic("expected a non-empty HTTP header name")
+	}
+
+	description := fmt.Sprintf("HTTP header '%s' with %s", name, describeValueExpectation(expected))
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			resp := extractHTTPResponse(t, "HaveHTTPHeaderWithValue", actual)
+			if values := resp.Header.Values(name); len(values) == 0 {
+				t.Fatalf("Expected HTTP header '%s' to be present, but it is not:\n%s", name, describeHTTPResponse(resp))
+			} else if failure := verifyFieldValue(t, expected, values[0]); failure != "" {
+				t.Fatalf("Expected HTTP header '%s' to match, but it does not: %s\n%s", name, failure, describeHTTPResponse(resp))
+			}
+		}
+	})
+	return Described(m, "to have "+description, "not to have "+description)
+}
@@ -0,0 +1,37 @@
+package justest_test
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestHaveHTTPHeaderWithValue(t *testing.T) {
+	t.Parallel()
+	headers := map[string]string{"Content-Type": "application/json"}
+	type testCase struct {
+		name     string
+		expected any
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Equal value":          {name: "Content-Type", expected: "application/json", verifier: SuccessVerifier()},
+		"Case-insensitive":     {name: "content-type", expected: "application/json", verifier: SuccessVerifier()},
+		"Matching value":       {name: "Content-Type", expected: Say("json"), verifier: SuccessVerifier()},
+		"Different value":      {name: "Content-Type", expected: "text/plain", verifier: FailureVerifier(`Expected HTTP header 'Content-Type' to match, but it does not: expected "text/plain", got "application/json"\nHTTP/1.1 200 OK\nContent-Type: application/json\n\n{}`)},
+		"Mismatching matcher":  {name: "Content-Type", expected: Say("xml"), verifier: FailureVerifier(`Expected HTTP header 'Content-Type' to match, but it does not: Expected actual value to match 'xml', but it does not: application/json\n`)},
+		"Missing header":       {name: "X-Request-Id", expected: Say(".+"), verifier: FailureVerifier(`Expected HTTP header 'X-Request-Id' to be present, but it is not:\nHTTP/1.1 200 OK\n`)},
+		"Negation of mismatch": {name: "Content-Type", expected: Not(Say("xml")), verifier: SuccessVerifier()},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(newHTTPRecorder(http.StatusOK, headers, "{}")).Will(HaveHTTPHeaderWithValue(tc.name, tc.expected)).Now()
+		})
+	}
+}
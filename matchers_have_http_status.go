@@ -0,0 +1,36 @@
+package justest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HaveHTTPStatus returns a matcher that checks that all given HTTP responses (an *http.Response or an
+// *httptest.ResponseRecorder) have one of the given status codes (e.g. http.StatusOK).
+//
+//go:noinline
+func HaveHTTPStatus(expected ...int) Matcher {
+	if len(expected) == 0 {
+		panic("expected at least one HTTP status code")
+	}
+	statuses := make([]string, len(expected))
+	for i, status := range expected {
+		statuses[i] = fmt.Sprint(status)
+	}
+	description := strings.Join(statuses, " or ")
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			resp := extractHTTPResponse(t, "HaveHTTPStatus", actual)
+			found := false
+			for _, status := range expected {
+				found = found || resp.StatusCode == status
+			}
+			if !found {
+				t.Fatalf("Expected HTTP status %s, but got %d:\n%s", description, resp.StatusCode, describeHTTPResponse(resp))
+			}
+		}
+	})
+	return Described(m, "to have HTTP status "+description, "not to have HTTP status "+description)
+}
@@ -0,0 +1,82 @@
+package justest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+// newHTTPRecorder returns a response recorder of a handler that responded with the given status, headers and body.
+func newHTTPRecorder(status int, headers map[string]string, body string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	for name, value := range headers {
+		rec.Header().Set(name, value)
+	}
+	rec.WriteHeader(status)
+	_, _ = rec.WriteString(body)
+	return rec
+}
+
+func TestHaveHTTPStatus(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		actual   func() any
+		expected []int
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Recorder with expected status": {
+			actual:   func() any { return newHTTPRecorder(http.StatusOK, nil, "") },
+			expected: []int{http.StatusOK},
+			verifier: SuccessVerifier(),
+		},
+		"Response with one of expected statuses": {
+			actual:   func() any { return newHTTPRecorder(http.StatusCreated, nil, "").Result() },
+			expected: []int{http.StatusOK, http.StatusCreated},
+			verifier: SuccessVerifier(),
+		},
+		"Unexpected status prints response": {
+			actual: func() any {
+				return newHTTPRecorder(http.StatusNotFound, map[string]string{"X-Request-Id": "123"}, "no such page")
+			},
+			expected: []int{http.StatusOK},
+			verifier: FailureVerifier(`Expected HTTP status 200, but got 404:\nHTTP/1.1 404 Not Found\nX-Request-Id: 123\n\nno such page`),
+		},
+		"Long bodies are truncated": {
+			actual:   func() any { return newHTTPRecorder(http.StatusInternalServerError, nil, strings.Repeat("x", 1500)) },
+			expected: []int{http.StatusOK},
+			verifier: FailureVerifier(`Expected HTTP status 200, but got 500:\n.*\n\nx+\.\.\. \(476 more bytes\)`),
+		},
+		"Unsupported actual": {
+			actual:   func() any { return "abc" },
+			expected: []int{http.StatusOK},
+			verifier: FailureVerifier(`Unsupported actual value for HaveHTTPStatus matcher: abc \(string\)`),
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual()).Will(HaveHTTPStatus(tc.expected...)).Now()
+		})
+	}
+}
+
+func TestHaveHTTPStatusWithServer(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	With(t).VerifyThat(err).Will(BeNil()).Now()
+	defer resp.Body.Close()
+	With(t).VerifyThat(resp).Will(HaveHTTPStatus(http.StatusTeapot)).Now()
+}
@@ -0,0 +1,216 @@
+package justest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPathSegment is a single step of a JSON path: an object key, an array index, or a wildcard.
+type jsonPathSegment struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// jsonPathMatch is a value found by a JSON path, along with its concrete path.
+type jsonPathMatch struct {
+	path  string
+	value any
+}
+
+// parseJSONPath parses the given JSON path expression, which supports the root ("$"), object keys (".key",
+// "['key']" or "[\"key\"]"), array indices ("[0]", or "[-1]" for the last element) and wildcards (".*" or "[*]").
+func parseJSONPath(path string) ([]jsonPathSegment, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("JSON path must start with '$': %s", path)
+	}
+
+	var segments []jsonPathSegment
+	for rest := path[1:]; rest != ""; {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[") + 1
+			if end == 0 {
+				end = len(rest)
+			}
+			name := rest[1:end]
+			if name == "" {
+				return nil, fmt.Errorf("empty key in JSON path: %s", path)
+			} else if name == "*" {
+				segments = append(segments, jsonPathSegment{wildcard: true})
+			} else {
+				segments = append(segments, jsonPathSegment{key: name})
+			}
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if len(rest) < 2 {
+				return nil, fmt.Errorf("unterminated index in JSON path: %s", path)
+			} else if rest[1] == '\'' || rest[1] == '"' {
+				closing := strings.IndexByte(rest[2:], rest[1]) + 2
+				if closing == 1 || closing+1 >= len(rest) || rest[closing+1] != ']' {
+					return nil, fmt.Errorf("unterminated key in JSON path: %s", path)
+				}
+				segments = append(segments, jsonPathSegment{key: rest[2:closing]})
+				rest = rest[closing+2:]
+				continue
+			} else if end < 0 {
+				return nil, fmt.Errorf("unterminated index in JSON path: %s", path)
+			}
+			index := rest[1:end]
+			if index == "*" {
+				segments = append(segments, jsonPathSegment{wildcard: true})
+			} else if i, err := strconv.Atoi(index); err != nil {
+				return nil, fmt.Errorf("invalid index '%s' in JSON path: %s", index, path)
+			} else {
+				segments = append(segments, jsonPathSegment{index: i, isIndex: true})
+			}
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected character '%c' in JSON path: %s", rest[0], path)
+		}
+	}
+	return segments, nil
+}
+
+// evaluateJSONPath returns the values found by the given JSON path segments in the given generic JSON document, or
+// the concrete path that could not be found.
+func evaluateJSONPath(doc any, segments []jsonPathSegment) ([]jsonPathMatch, string) {
+	matches := []jsonPathMatch{{path: "$", value: doc}}
+	for _, segment := range segments {
+		var next []jsonPathMatch
+		for _, match := range matches {
+			switch value := match.value.(type) {
+			case map[string]any:
+				if segment.wildcard {
+					keys := make([]string, 0, len(value))
+					for k := range value {
+						keys = append(keys, k)
+					}
+					sort.Strings(keys)
+					for _, k := range keys {
+						next = append(next, jsonPathMatch{path: jsonPathOfKey(match.path, k), value: value[k]})
+					}
+				} else if segment.isIndex {
+					return nil, fmt.Sprintf("%s[%d]", match.path, segment.index)
+				} else if v, ok := value[segment.key]; ok {
+					next = append(next, jsonPathMatch{path: jsonPathOfKey(match.path, segment.key), value: v})
+				} else {
+					return nil, jsonPathOfKey(match.path, segment.key)
+				}
+			case []any:
+				if segment.wildcard {
+					for i, v := range value {
+						next = append(next, jsonPathMatch{path: fmt.Sprintf("%s[%d]", match.path, i), value: v})
+					}
+				} else if !segment.isIndex {
+					return nil, jsonPathOfKey(match.path, segment.key)
+				} else if i := segment.index; i >= -len(value) && i < len(value) {
+					if i < 0 {
+						i += len(value)
+					}
+					next = append(next, jsonPathMatch{path: fmt.Sprintf("%s[%d]", match.path, i), value: value[i]})
+				} else {
+					return nil, fmt.Sprintf("%s[%d]", match.path, segment.index)
+				}
+			default:
+				switch {
+				case segment.wildcard:
+					return nil, match.path + "[*]"
+				case segment.isIndex:
+					return nil, fmt.Sprintf("%s[%d]", match.path, segment.index)
+				default:
+					return nil, jsonPathOfKey(match.path, segment.key)
+				}
+			}
+		}
+		matches = next
+	}
+	return matches, ""
+}
+
+// jsonValue converts the given generic JSON value to the value given to nested matchers: numbers become int if they
+// are integral, or float64 otherwise; objects and arrays are converted recursively.
+func jsonValue(v any) any {
+	switch value := v.(type) {
+	case json.Number:
+		if i, err := strconv.ParseInt(string(value), 10, 0); err == nil {
+			return int(i)
+		} else if f, err := value.Float64(); err == nil && f == math.Trunc(f) && f >= math.MinInt && f <= math.MaxInt {
+			return int(f)
+		} else if err == nil {
+			return f
+		}
+		return string(value)
+	case map[string]any:
+		m := make(map[string]any, len(value))
+		for k, e := range value {
+			m[k] = jsonValue(e)
+		}
+		return m
+	case []any:
+		s := make([]any, len(value))
+		for i, e := range value {
+			s[i] = jsonValue(e)
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+// HaveJSONPath returns a matcher that evaluates the given JSON path against all given actual values (decoded as JSON
+// documents, like in MatchJSON) and applies the given matcher to the result. JSON numbers are given to the matcher as
+// int if they are integral, or float64 otherwise. If the path contains wildcards (e.g. "$.items[*].id"), the matcher
+// receives a []any of all found values; otherwise, it receives the single found value.
+//
+//go:noinline
+func HaveJSONPath(path string, m Matcher) Matcher {
+	if m == nil {
+		panic("expected a non-nil matcher")
+	}
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		panic(err.Error())
+	}
+	wildcard := false
+	for _, segment := range segments {
+		wildcard = wildcard || segment.wildcard
+	}
+
+	jm := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			doc, err := parseJSON(jsonValueExtractor.MustExtractValue(t, actual))
+			if err != nil {
+				t.Fatalf("Unsupported actual value for HaveJSONPath matcher: %v", err)
+			}
+
+			matches, missing := evaluateJSONPath(doc, segments)
+			if missing != "" {
+				t.Fatalf("Expected JSON path '%s' to exist, but '%s' does not exist in: %s", path, missing, formatJSON(doc))
+			}
+
+			var result any
+			if wildcard {
+				values := make([]any, len(matches))
+				for i, match := range matches {
+					values[i] = jsonValue(match.value)
+				}
+				result = values
+			} else {
+				result = jsonValue(matches[0].value)
+			}
+			if failure := tryAssert(t, m, result); failure != nil {
+				t.Fatalf("JSON path '%s' resolved to %s, which did not match: %s", path, formatJSON(result), failure)
+			}
+		}
+	})
+	description := fmt.Sprintf("JSON path '%s' with a value expected %s", path, describe(m))
+	return Described(jm, "to have "+description, "not to have "+description)
+}
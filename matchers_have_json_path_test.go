@@ -0,0 +1,88 @@
+package justest_test
+
+import (
+	"regexp"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestHaveJSONPath(t *testing.T) {
+	t.Parallel()
+	const doc = `{"data":{"items":[{"id":1,"price":9.5},{"id":2,"price":10},{"id":3,"price":12}],"odd key":"x"},"ok":true}`
+	type testCase struct {
+		actual   any
+		path     string
+		matcher  Matcher
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Root":                         {actual: `[1,2]`, path: "$", matcher: EqualTo([]any{1, 2}), verifier: SuccessVerifier()},
+		"Object key":                   {actual: doc, path: "$.ok", matcher: EqualTo(true), verifier: SuccessVerifier()},
+		"Integral number is int":       {actual: doc, path: "$.data.items[1].price", matcher: EqualTo(10), verifier: SuccessVerifier()},
+		"Fractional number is float64": {actual: doc, path: "$.data.items[0].price", matcher: EqualTo(9.5), verifier: SuccessVerifier()},
+		"Negative index":               {actual: doc, path: "$.data.items[-1].id", matcher: EqualTo(3), verifier: SuccessVerifier()},
+		"Bracketed key":                {actual: doc, path: `$.data['odd key']`, matcher: EqualTo("x"), verifier: SuccessVerifier()},
+		"Double-quoted bracketed key":  {actual: doc, path: `$["data"]["odd key"]`, matcher: Say("^x$"), verifier: SuccessVerifier()},
+		"Array wildcard":               {actual: doc, path: "$.data.items[*].id", matcher: ConsistOf(1, 2, 3), verifier: SuccessVerifier()},
+		"Object wildcard":              {actual: `{"b":2,"a":1}`, path: "$.*", matcher: EqualTo([]any{1, 2}), verifier: SuccessVerifier()},
+		"Nested object":                {actual: doc, path: "$.data.items[0]", matcher: HaveKeyWithValue("id", 1), verifier: SuccessVerifier()},
+		"Marshallable actual":          {actual: map[string][]int{"a": {1, 2}}, path: "$.a[1]", matcher: EqualTo(2), verifier: SuccessVerifier()},
+		"Function actual":              {actual: func() ([]byte, error) { return []byte(doc), nil }, path: "$.ok", matcher: EqualTo(true), verifier: SuccessVerifier()},
+		"Value does not match": {
+			actual:   doc,
+			path:     "$.data.items[*].id",
+			matcher:  ConsistOf(1, 2),
+			verifier: FailureVerifier(regexp.QuoteMeta(`JSON path '$.data.items[*].id' resolved to [1,2,3], which did not match: `)),
+		},
+		"Missing key": {
+			actual:   doc,
+			path:     "$.data.items[0].name",
+			matcher:  EqualTo("x"),
+			verifier: FailureVerifier(regexp.QuoteMeta(`Expected JSON path '$.data.items[0].name' to exist, but '$.data.items[0].name' does not exist in: `)),
+		},
+		"Index out of range": {
+			actual:   doc,
+			path:     "$.data.items[3].id",
+			matcher:  EqualTo(1),
+			verifier: FailureVerifier(regexp.QuoteMeta(`Expected JSON path '$.data.items[3].id' to exist, but '$.data.items[3]' does not exist in: `)),
+		},
+		"Key of scalar": {
+			actual:   doc,
+			path:     "$.ok.value",
+			matcher:  EqualTo(1),
+			verifier: FailureVerifier(regexp.QuoteMeta(`Expected JSON path '$.ok.value' to exist, but '$.ok.value' does not exist in: `)),
+		},
+		"Invalid JSON": {
+			actual:   `{`,
+			path:     "$.a",
+			matcher:  EqualTo(1),
+			verifier: FailureVerifier(regexp.QuoteMeta(`Unsupported actual value for HaveJSONPath matcher: invalid JSON`)),
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(HaveJSONPath(tc.path, tc.matcher)).Now()
+		})
+	}
+}
+
+func TestHaveJSONPathInvalidPath(t *testing.T) {
+	t.Parallel()
+	for path, message := range map[string]string{
+		"a.b":    `^JSON path must start with '\$': a\.b$`,
+		"$..a":   `^empty key in JSON path`,
+		"$[abc]": `^invalid index 'abc' in JSON path`,
+		"$[1":    `^unterminated index in JSON path`,
+		"$['a]":  `^unterminated key in JSON path`,
+		"$a":     `^unexpected character 'a' in JSON path`,
+	} {
+		path, message := path, message
+		With(t).VerifyThat(func() { HaveJSONPath(path, BeNil()) }).Will(PanicWith(Say(message))).Now()
+	}
+}
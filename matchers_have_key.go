@@ -0,0 +1,86 @@
+package justest
+
+import (
+	"reflect"
+)
+
+var (
+	mapValueExtractor ValueExtractor
+)
+
+func init() {
+	mapValueExtractor = NewValueExtractor(ExtractorUnsupported)
+	mapValueExtractor[reflect.Func] = NewFuncExtractor(mapValueExtractor, true)
+	mapValueExtractor[reflect.Map] = ExtractSameValue
+	mapValueExtractor[reflect.Pointer] = NewPointerExtractor(mapValueExtractor, true)
+}
+
+// describeKey describes the given key expectation, which is either a Matcher or a value.
+func describeKey(expected any) string {
+	if m, ok := expected.(Matcher); ok {
+		return "a key expected " + describe(m)
+	}
+	return "key " + describeValue(expected)
+}
+
+//go:noinline
+func HaveKey(key any) Matcher {
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			v := mapValueExtractor.MustExtractValue(t, actual)
+			found := false
+			for iter := reflect.ValueOf(v).MapRange(); iter.Next(); {
+				if elementMatches(t, key, iter.Key().Interface()) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Fatalf("Expected '%+v' to have %s, but it does not", v, describeKey(key))
+			}
+		}
+	})
+	return Described(m, "to have "+describeKey(key), "not to have "+describeKey(key))
+}
+
+//go:noinline
+func HaveKeyWithValue(key, value any) Matcher {
+	description := describeKey(key) + " with " + describeValueExpectation(value)
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			v := mapValueExtractor.MustExtractValue(t, actual)
+
+			var valuesOfMatchingKeys []any
+			found := false
+			for iter := reflect.ValueOf(v).MapRange(); iter.Next(); {
+				if elementMatches(t, key, iter.Key().Interface()) {
+					mapValue := iter.Value().Interface()
+					if elementMatches(t, value, mapValue) {
+						found = true
+						break
+					}
+					valuesOfMatchingKeys = append(valuesOfMatchingKeys, mapValue)
+				}
+			}
+
+			if found {
+				continue
+			} else if len(valuesOfMatchingKeys) == 0 {
+				t.Fatalf("Expected '%+v' to have %s, but it does not have such a key", v, description)
+			} else {
+				t.Fatalf("Expected '%+v' to have %s, but it has %s", v, description, describeValues(valuesOfMatchingKeys))
+			}
+		}
+	})
+	return Described(m, "to have "+description, "not to have "+description)
+}
+
+// describeValueExpectation describes the given value expectation, which is either a Matcher or a value.
+func describeValueExpectation(expected any) string {
+	if m, ok := expected.(Matcher); ok {
+		return "a value expected " + describe(m)
+	}
+	return "value " + describeValue(expected)
+}
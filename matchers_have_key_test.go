@@ -0,0 +1,61 @@
+package justest_test
+
+import (
+	"regexp"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/internal"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestHaveKey(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		actual   any
+		key      any
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Has key":                {actual: map[string]int{"a": 1}, key: "a", verifier: SuccessVerifier()},
+		"Missing key":            {actual: map[string]int{"a": 1}, key: "b", verifier: FailureVerifier(regexp.QuoteMeta(`Expected 'map[a:1]' to have key "b", but it does not`))},
+		"Nested matcher":         {actual: map[string]int{"abc": 1}, key: Say("^a"), verifier: SuccessVerifier()},
+		"Nested matcher fails":   {actual: map[string]int{"abc": 1}, key: Say("^b"), verifier: FailureVerifier(regexp.QuoteMeta(`Expected 'map[abc:1]' to have a key expected to match '^b', but it does not`))},
+		"Pointer to map":         {actual: Ptr(map[int]int{1: 1}), key: 1, verifier: SuccessVerifier()},
+		"Unsupported type fails": {actual: []int{1}, key: 1, verifier: FailureVerifier(regexp.QuoteMeta(`Unsupported actual value: [1]`))},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(HaveKey(tc.key)).Now()
+		})
+	}
+}
+
+func TestHaveKeyWithValue(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		actual     any
+		key, value any
+		verifier   TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Has key with value": {actual: map[string]int{"a": 1}, key: "a", value: 1, verifier: SuccessVerifier()},
+		"Missing key":        {actual: map[string]int{"a": 1}, key: "b", value: 1, verifier: FailureVerifier(regexp.QuoteMeta(`Expected 'map[a:1]' to have key "b" with value 1, but it does not have such a key`))},
+		"Different value":    {actual: map[string]int{"a": 1}, key: "a", value: 2, verifier: FailureVerifier(regexp.QuoteMeta(`Expected 'map[a:1]' to have key "a" with value 2, but it has 1`))},
+		"Nested matchers":    {actual: map[string]int{"abc": 7}, key: Say("^a"), value: BeGreaterThan(5), verifier: SuccessVerifier()},
+		"Nested value fails": {actual: map[string]int{"abc": 3}, key: Say("^a"), value: BeGreaterThan(5), verifier: FailureVerifier(regexp.QuoteMeta(`Expected 'map[abc:3]' to have a key expected to match '^a' with a value expected to be greater than 5, but it has 3`))},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(HaveKeyWithValue(tc.key, tc.value)).Now()
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package justest
+
+import (
+	"fmt"
+)
+
+//go:noinline
+func HaveLen(expected int) Matcher {
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			length := lengthValueExtractor.MustExtractValue(t, actual).(int)
+			if length != expected {
+				t.Fatalf("Expected '%+v' to have a length of %d, but it has a length of %d", actual, expected, length)
+			}
+		}
+	})
+	return Described(m, fmt.Sprintf("to have a length of %d", expected), fmt.Sprintf("not to have a length of %d", expected))
+}
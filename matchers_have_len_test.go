@@ -0,0 +1,40 @@
+package justest_test
+
+import (
+	"regexp"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/internal"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestHaveLen(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		actual   any
+		expected int
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Array matches":          {actual: [3]int{1, 2, 3}, expected: 3, verifier: SuccessVerifier()},
+		"Array fails":            {actual: [3]int{1, 2, 3}, expected: 2, verifier: FailureVerifier(regexp.QuoteMeta(`Expected '[1 2 3]' to have a length of 2, but it has a length of 3`))},
+		"Chan matches":           {actual: ChanOf(1, 2), expected: 2, verifier: SuccessVerifier()},
+		"Map matches":            {actual: map[int]int{1: 1}, expected: 1, verifier: SuccessVerifier()},
+		"Map fails":              {actual: map[int]int{1: 1}, expected: 0, verifier: FailureVerifier(regexp.QuoteMeta(`Expected 'map[1:1]' to have a length of 0, but it has a length of 1`))},
+		"Pointer to slice match": {actual: Ptr([]int{1, 2}), expected: 2, verifier: SuccessVerifier()},
+		"Slice matches":          {actual: []string{"a"}, expected: 1, verifier: SuccessVerifier()},
+		"String matches":         {actual: "abc", expected: 3, verifier: SuccessVerifier()},
+		"String fails":           {actual: "abc", expected: 4, verifier: FailureVerifier(`Expected 'abc' to have a length of 4, but it has a length of 3`)},
+		"Unsupported type fails": {actual: 1, expected: 1, verifier: FailureVerifier(`Unsupported actual value: 1`)},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(HaveLen(tc.expected)).Now()
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package justest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var (
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// errorExpectation is a compiled expectation of an error, created by newErrorExpectation.
+type errorExpectation struct {
+	description string
+	verify      func(t T, err error) (extracted any, failure string)
+}
+
+// newErrorExpectation compiles the given expectation, which must be one of:
+//   - an error, which is checked using errors.Is
+//   - a non-nil pointer to an error type (or to an interface type), which is checked using errors.As; the extracted
+//     value is stored in the pointer as well
+//   - a string, which is treated as a regular expression the error message must match
+//   - a Matcher, which is applied to the error message
+//
+//go:noinline
+func newErrorExpectation(expectation any) errorExpectation {
+	switch e := expectation.(type) {
+	case nil:
+		panic("expected a non-nil error expectation")
+	case Matcher:
+		return errorExpectation{
+			description: "with a message expected " + describe(e),
+			verify: func(t T, err error) (any, string) {
+				GetHelper(t).Helper()
+				if failure := tryAssert(t, e, err.Error()); failure != nil {
+					return nil, fmt.Sprintf("Error message did not match: %s", failure)
+				}
+				return err, ""
+			},
+		}
+	case string:
+		re := regexp.MustCompile(e)
+		return errorExpectation{
+			description: fmt.Sprintf("with a message matching '%s'", re),
+			verify: func(t T, err error) (any, string) {
+				GetHelper(t).Helper()
+				if !re.MatchString(err.Error()) {
+					return nil, fmt.Sprintf("Expected error message to match '%s', but it does not: %s", re, err)
+				}
+				return err, ""
+			},
+		}
+	case error:
+		return errorExpectation{
+			description: fmt.Sprintf("with an error matching '%v'", e),
+			verify: func(t T, err error) (any, string) {
+				GetHelper(t).Helper()
+				if !errors.Is(err, e) {
+					return nil, fmt.Sprintf("Expected error '%v' to match '%v' (using errors.Is), but it does not", err, e)
+				}
+				return err, ""
+			},
+		}
+	}
+
+	rv := reflect.ValueOf(expectation)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		panic(fmt.Sprintf("unsupported error expectation: %+v (%T)", expectation, expectation))
+	}
+	targetType := rv.Type().Elem()
+	if targetType.Kind() != reflect.Interface && !targetType.Implements(errorType) {
+		panic(fmt.Sprintf("unsupported error expectation: %T does not point to an error type or interface", expectation))
+	}
+	return errorExpectation{
+		description: fmt.Sprintf("with an error assignable to '%s'", targetType),
+		verify: func(t T, err error) (any, string) {
+			GetHelper(t).Helper()
+			target := reflect.New(targetType)
+			if !errors.As(err, target.Interface()) {
+				return nil, fmt.Sprintf("Expected error '%v' to be assignable to '%s' (using errors.As), but it is not", err, targetType)
+			}
+			rv.Elem().Set(target.Elem())
+			return target.Elem().Interface(), ""
+		},
+	}
+}
+
+// lastError returns the last actual value as an error, failing the given T if it is missing, nil, or not an error.
+//
+//go:noinline
+func lastError(t T, actuals []any) error {
+	GetHelper(t).Helper()
+	if l := len(actuals); l > 0 {
+		if err, ok := actuals[l-1].(error); ok && err != nil {
+			return err
+		}
+	}
+	t.Fatalf("No error occurred")
+	panic("unreachable")
+}
+
+// MatchError returns a matcher that checks the last actual value is an error that satisfies the given target, which
+// can be an error (checked using errors.Is), a pointer to an error type or interface (checked using errors.As, and
+// populated with the extracted error), a regular expression string the error message must match, or a Matcher that is
+// applied to the error message. The given follow-up matchers are then applied to the matched error (for errors.As
+// targets, to the extracted error).
+//
+//go:noinline
+func MatchError(target any, matchers ...Matcher) Matcher {
+	expectation := newErrorExpectation(target)
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		extracted, failure := expectation.verify(t, lastError(t, actuals))
+		if failure != "" {
+			t.Fatalf("%s", failure)
+		}
+		for _, followUp := range matchers {
+			followUp.Assert(t, extracted)
+		}
+	})
+
+	description := "to fail " + expectation.description
+	negation := "not to fail " + expectation.description
+	if len(matchers) > 0 {
+		descriptions := make([]string, len(matchers))
+		for i, followUp := range matchers {
+			descriptions[i] = describe(followUp)
+		}
+		description += " that is expected " + strings.Join(descriptions, " and ")
+		negation += " that is expected " + strings.Join(descriptions, " and ")
+	}
+	return Described(m, description, negation)
+}
@@ -0,0 +1,132 @@
+package justest_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+type validationError struct {
+	Field string
+}
+
+func (e *validationError) Error() string { return fmt.Sprintf("invalid field: %s", e.Field) }
+
+func TestMatchError(t *testing.T) {
+	t.Parallel()
+	wrappedNotExist := fmt.Errorf("failed opening file: %w", fs.ErrNotExist)
+	wrappedValidation := fmt.Errorf("failed validating: %w", &validationError{Field: "name"})
+	type testCase struct {
+		actuals  []any
+		matcher  func() Matcher
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"No actuals fails": {
+			actuals:  []any{},
+			matcher:  func() Matcher { return MatchError(fs.ErrNotExist) },
+			verifier: FailureVerifier(`^No error occurred`),
+		},
+		"Nil error fails": {
+			actuals:  []any{1, nil},
+			matcher:  func() Matcher { return MatchError(fs.ErrNotExist) },
+			verifier: FailureVerifier(`^No error occurred`),
+		},
+		"Sentinel error matches": {
+			actuals:  []any{1, wrappedNotExist},
+			matcher:  func() Matcher { return MatchError(fs.ErrNotExist) },
+			verifier: SuccessVerifier(),
+		},
+		"Sentinel error mismatches": {
+			actuals:  []any{wrappedNotExist},
+			matcher:  func() Matcher { return MatchError(fs.ErrExist) },
+			verifier: FailureVerifier(`^Expected error 'failed opening file: file does not exist' to match 'file already exists' \(using errors\.Is\), but it does not`),
+		},
+		"Error type matches": {
+			actuals:  []any{wrappedValidation},
+			matcher:  func() Matcher { var target *validationError; return MatchError(&target) },
+			verifier: SuccessVerifier(),
+		},
+		"Error type mismatches": {
+			actuals:  []any{wrappedNotExist},
+			matcher:  func() Matcher { var target *validationError; return MatchError(&target) },
+			verifier: FailureVerifier(`^Expected error 'failed opening file: file does not exist' to be assignable to '\*justest_test\.validationError' \(using errors\.As\), but it is not`),
+		},
+		"Extracted error is passed to follow-up matchers": {
+			actuals: []any{wrappedValidation},
+			matcher: func() Matcher {
+				var target *validationError
+				return MatchError(&target, EqualTo(&validationError{Field: "name"}))
+			},
+			verifier: SuccessVerifier(),
+		},
+		"Follow-up matcher failure is propagated": {
+			actuals: []any{wrappedValidation},
+			matcher: func() Matcher {
+				var target *validationError
+				return MatchError(&target, MatcherFunc(func(t T, actuals ...any) {
+					t.Fatalf("invalid field '%s'", actuals[0].(*validationError).Field)
+				}))
+			},
+			verifier: FailureVerifier(`^invalid field 'name'`),
+		},
+		"Message pattern matches": {
+			actuals:  []any{wrappedNotExist},
+			matcher:  func() Matcher { return MatchError(`does not exist$`) },
+			verifier: SuccessVerifier(),
+		},
+		"Message pattern mismatches": {
+			actuals:  []any{wrappedNotExist},
+			matcher:  func() Matcher { return MatchError(`^abc$`) },
+			verifier: FailureVerifier(`^Expected error message to match '\^abc\$', but it does not: failed opening file: file does not exist`),
+		},
+		"Message matcher matches": {
+			actuals:  []any{wrappedNotExist},
+			matcher:  func() Matcher { return MatchError(EqualTo("failed opening file: file does not exist")) },
+			verifier: SuccessVerifier(),
+		},
+		"Message matcher mismatches": {
+			actuals:  []any{wrappedNotExist},
+			matcher:  func() Matcher { return MatchError(Say(`^abc$`)) },
+			verifier: FailureVerifier(`^Error message did not match: Expected actual value to match '\^abc\$', but it does not: failed opening file: file does not exist`),
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actuals...).Will(tc.matcher()).Now()
+		})
+	}
+	t.Run("Extracted error is stored in target", func(t *testing.T) {
+		t.Parallel()
+		var target *validationError
+		With(t).VerifyThat(wrappedValidation).Will(MatchError(&target)).Now()
+		With(t).VerifyThat(target).Will(EqualTo(&validationError{Field: "name"})).Now()
+	})
+	t.Run("Unsupported target panics", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(PanicVerifier(`unsupported error expectation: 1 \(int\)`))
+		MatchError(1)
+	})
+	t.Run("Pointer to non-error type panics", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(PanicVerifier(`unsupported error expectation: \*int does not point to an error type or interface`))
+		var i int
+		MatchError(&i)
+	})
+	t.Run("Joined errors", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		With(mt).VerifyThat(errors.Join(fs.ErrExist, fs.ErrNotExist)).Will(MatchError(fs.ErrNotExist)).Now()
+	})
+}
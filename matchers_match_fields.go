@@ -0,0 +1,82 @@
+package justest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Fields maps struct field names to their expectations, which are either Matcher instances or values.
+type Fields map[string]any
+
+// FieldsOptions control how MatchFields treats fields that are not listed, or listed fields that do not exist.
+type FieldsOptions int
+
+const (
+	// IgnoreExtras makes MatchFields ignore exported struct fields that are not listed in the expected Fields.
+	IgnoreExtras FieldsOptions = 1 << iota
+
+	// IgnoreMissing makes MatchFields ignore expected Fields that do not exist in the struct.
+	IgnoreMissing
+)
+
+// MatchFields returns a matcher that checks that the fields of all given structs (or pointers to structs) match their
+// corresponding expectations in the given Fields, reporting every field that does not. Unless IgnoreExtras is given,
+// every exported field must be listed; unless IgnoreMissing is given, every listed field must exist.
+//
+//go:noinline
+func MatchFields(options FieldsOptions, fields Fields) Matcher {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			v := structValueExtractor.MustExtractValue(t, actual)
+			rv := reflect.ValueOf(v)
+			for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+				rv = rv.Elem()
+			}
+			if rv.Kind() != reflect.Struct {
+				t.Fatalf("Unsupported actual value for MatchFields matcher (expected a struct): %+v", v)
+			}
+
+			var failures []string
+			for _, name := range names {
+				field, ok := rv.Type().FieldByName(name)
+				if !ok || !field.IsExported() {
+					if options&IgnoreMissing == 0 {
+						failures = append(failures, fmt.Sprintf("%s: no such exported field", name))
+					}
+				} else if value, nilEmbedded := fieldByIndex(rv, field.Index); nilEmbedded != "" {
+					failures = append(failures, fmt.Sprintf("%s: promoted through nil embedded field '%s'", name, nilEmbedded))
+				} else if failure := verifyFieldValue(t, fields[name], value.Interface()); failure != "" {
+					failures = append(failures, fmt.Sprintf("%s: %s", name, failure))
+				}
+			}
+			if options&IgnoreExtras == 0 {
+				for i := 0; i < rv.NumField(); i++ {
+					field := rv.Type().Field(i)
+					if _, listed := fields[field.Name]; field.IsExported() && !listed {
+						failures = append(failures, fmt.Sprintf("%s: unexpected field", field.Name))
+					}
+				}
+			}
+
+			if len(failures) > 0 {
+				t.Fatalf("Expected fields of '%s' to match, but %d did not:%s", rv.Type(), len(failures), formatFailures(failures))
+			}
+		}
+	})
+
+	descriptions := make([]string, len(names))
+	for i, name := range names {
+		descriptions[i] = fmt.Sprintf("%s with %s", name, describeValueExpectation(fields[name]))
+	}
+	description := "fields " + strings.Join(descriptions, ", ")
+	return Described(m, "to have "+description, "not to have "+description)
+}
@@ -0,0 +1,82 @@
+package justest_test
+
+import (
+	"regexp"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestMatchFields(t *testing.T) {
+	t.Parallel()
+	obj := fieldsObject{Name: "svc-web", Spec: &fieldsSpec{Replicas: 3}, Port: 8080}
+	type testCase struct {
+		actual   any
+		options  FieldsOptions
+		fields   Fields
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"All fields match": {
+			actual:   obj,
+			fields:   Fields{"Name": Say("^svc-"), "Spec": HaveField("Replicas", 3), "Port": BeBetween(1, 65535)},
+			verifier: SuccessVerifier(),
+		},
+		"Pointer actual": {
+			actual:   &obj,
+			options:  IgnoreExtras,
+			fields:   Fields{"Port": 8080},
+			verifier: SuccessVerifier(),
+		},
+		"Every mismatching field is reported": {
+			actual:   obj,
+			options:  IgnoreExtras,
+			fields:   Fields{"Name": Say("^api-"), "Port": 80},
+			verifier: FailureVerifier(`Expected fields of 'justest_test.fieldsObject' to match, but 2 did not:\n\[1\] Name: Expected actual value to match '\^api-', but it does not: svc-web\n\[2\] Port: expected 80, got 8080`),
+		},
+		"Extra fields fail": {
+			actual:   obj,
+			fields:   Fields{"Name": Say("^svc-")},
+			verifier: FailureVerifier(regexp.QuoteMeta("but 2 did not:\n[1] Spec: unexpected field\n[2] Port: unexpected field")),
+		},
+		"Extra fields ignored": {
+			actual:   obj,
+			options:  IgnoreExtras,
+			fields:   Fields{"Name": Say("^svc-")},
+			verifier: SuccessVerifier(),
+		},
+		"Missing fields fail": {
+			actual:   obj,
+			options:  IgnoreExtras,
+			fields:   Fields{"Name": Say("^svc-"), "Host": "localhost", "hidden": ""},
+			verifier: FailureVerifier(regexp.QuoteMeta("but 2 did not:\n[1] Host: no such exported field\n[2] hidden: no such exported field")),
+		},
+		"Missing fields ignored": {
+			actual:   obj,
+			options:  IgnoreExtras | IgnoreMissing,
+			fields:   Fields{"Name": Say("^svc-"), "Host": "localhost"},
+			verifier: SuccessVerifier(),
+		},
+		"Promoted field through nil embedded pointer": {
+			actual:   fieldsDerived{Port: 80},
+			options:  IgnoreExtras,
+			fields:   Fields{"Name": "base", "Port": 80},
+			verifier: FailureVerifier(regexp.QuoteMeta("but 1 did not:\n[1] Name: promoted through nil embedded field 'FieldsBase'")),
+		},
+		"Non-struct actual fails": {
+			actual:   []int{1},
+			fields:   Fields{},
+			verifier: FailureVerifier(regexp.QuoteMeta(`Unsupported actual value for MatchFields matcher (expected a struct): [1]`)),
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(MatchFields(tc.options, tc.fields)).Now()
+		})
+	}
+}
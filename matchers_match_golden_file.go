@@ -0,0 +1,162 @@
+package justest
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arikkfir/justest/internal"
+)
+
+// UpdateGoldenFilesEnvVarName is the environment variable that, when set to a true value (e.g. "1"), makes
+// MatchGoldenFile write the (normalized) actual value to the golden file instead of comparing with it.
+const UpdateGoldenFilesEnvVarName = "JUSTEST_UPDATE_GOLDEN_FILES"
+
+var (
+	goldenFileValueExtractor ValueExtractor
+	timestampRegex           = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`)
+)
+
+func init() {
+	goldenFileValueExtractor = newTextValueExtractor("MatchGoldenFile")
+}
+
+// envUpdateGoldenFiles returns true if the JUSTEST_UPDATE_GOLDEN_FILES environment variable is set to a true value.
+func envUpdateGoldenFiles() bool {
+	update, _ := strconv.ParseBool(os.Getenv(UpdateGoldenFilesEnvVarName))
+	return update
+}
+
+// Normalizer transforms text before it is compared with a golden file, in order to ignore insignificant differences
+// such as line endings, timestamps or temporary paths. Normalizers are applied to both the golden file contents and the
+// actual value, in the order given to MatchGoldenFile.
+type Normalizer func(string) string
+
+// NormalizeLineEndings returns a normalizer that converts Windows ("\r\n") and old Mac ("\r") line endings to "\n".
+//
+//go:noinline
+func NormalizeLineEndings() Normalizer {
+	return func(s string) string {
+		return strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\r", "\n")
+	}
+}
+
+// NormalizeTrailingWhitespace returns a normalizer that removes trailing spaces and tabs from every line.
+//
+//go:noinline
+func NormalizeTrailingWhitespace() Normalizer {
+	return func(s string) string {
+		lines := strings.Split(s, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(line, " \t")
+		}
+		return strings.Join(lines, "\n")
+	}
+}
+
+// NormalizeMatches returns a normalizer that replaces all matches of the given regular expression with the given
+// replacement, which can refer to submatches (e.g. "$1"), as in regexp.Regexp.ReplaceAllString.
+//
+//go:noinline
+func NormalizeMatches(re *regexp.Regexp, replacement string) Normalizer {
+	if re == nil {
+		panic("expected a non-nil regular expression")
+	}
+	return func(s string) string {
+		return re.ReplaceAllString(s, replacement)
+	}
+}
+
+// NormalizeTimestamps returns a normalizer that replaces RFC 3339-like timestamps (e.g. "2006-01-02T15:04:05Z" or
+// "2006-01-02 15:04:05.999+07:00") with "<TIMESTAMP>". Use NormalizeMatches for other timestamp formats.
+//
+//go:noinline
+func NormalizeTimestamps() Normalizer {
+	return NormalizeMatches(timestampRegex, "<TIMESTAMP>")
+}
+
+// NormalizeTempDirs returns a normalizer that replaces absolute paths in the system's temporary directory (see
+// os.TempDir) with "<TMPDIR>"; the first path element under the temporary directory is replaced as well, since it is
+// randomly named by os.MkdirTemp and testing.T.TempDir (e.g. "/tmp/TestFoo123/001/out.txt" becomes
+// "<TMPDIR>/001/out.txt").
+//
+//go:noinline
+func NormalizeTempDirs() Normalizer {
+	dirs := []string{filepath.Clean(os.TempDir())}
+	if resolved, err := filepath.EvalSymlinks(dirs[0]); err == nil && resolved != dirs[0] {
+		dirs = append(dirs, resolved)
+	}
+
+	// Match longer directories first, so that a directory is never replaced by a prefix of itself
+	sort.Slice(dirs, func(i, j int) bool { return len(dirs[i]) > len(dirs[j]) })
+	patterns := make([]string, len(dirs))
+	for i, dir := range dirs {
+		patterns[i] = regexp.QuoteMeta(dir)
+	}
+	re := regexp.MustCompile(`(?:` + strings.Join(patterns, "|") + `)(?:[/\\][^\s/\\"'` + "`" + `]+)?`)
+	return NormalizeMatches(re, "<TMPDIR>")
+}
+
+// normalize applies the given normalizers to the given text, in order.
+func normalize(s string, normalizers []Normalizer) string {
+	for _, n := range normalizers {
+		s = n(s)
+	}
+	return s
+}
+
+//go:noinline
+func matchGoldenFile(path string, update func() bool, normalizers []Normalizer) Matcher {
+	for _, n := range normalizers {
+		if n == nil {
+			panic("expected non-nil normalizers")
+		}
+	}
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, v := range actuals {
+			actual := normalize(goldenFileValueExtractor.MustExtractValue(t, v).(string), normalizers)
+
+			if update() {
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatalf("Failed creating directory of golden file '%s': %+v", path, err)
+				} else if err := os.WriteFile(path, []byte(actual), 0644); err != nil {
+					t.Fatalf("Failed writing golden file '%s': %+v", path, err)
+				}
+				t.Logf("Updated golden file '%s'", path)
+				continue
+			}
+
+			data, err := os.ReadFile(path)
+			if errors.Is(err, fs.ErrNotExist) {
+				t.Fatalf("Golden file '%s' does not exist (set %s=1 to create it)", path, UpdateGoldenFilesEnvVarName)
+			} else if err != nil {
+				t.Fatalf("Failed reading golden file '%s': %+v", path, err)
+			}
+
+			expected := normalize(string(data), normalizers)
+			if expected != actual {
+				diff := internal.UnifiedDiff(path, "actual", expected, actual, 3)
+				t.Fatalf("Expected actual value to match golden file '%s' (set %s=1 to update it), but it differs:\n%s", path, UpdateGoldenFilesEnvVarName, strings.TrimSuffix(diff, "\n"))
+			}
+		}
+	})
+	return Described(m, "to match golden file '"+path+"'", "not to match golden file '"+path+"'")
+}
+
+// MatchGoldenFile returns a matcher that checks that all given actual values match the contents of the given golden
+// file (usually under the package's "testdata" directory), after applying the given normalizers to both. Actual values
+// can be strings, byte slices, buffers, readers (or pointers to these), or functions or channels providing such values.
+// Mismatches are reported with a unified diff. If the JUSTEST_UPDATE_GOLDEN_FILES environment variable is set, the
+// golden file is (re)written with the normalized actual value instead.
+//
+//go:noinline
+func MatchGoldenFile(path string, normalizers ...Normalizer) Matcher {
+	return matchGoldenFile(path, envUpdateGoldenFiles, normalizers)
+}
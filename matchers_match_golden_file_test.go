@@ -0,0 +1,115 @@
+package justest_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/internal"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestMatchGoldenFile(t *testing.T) {
+	const greeting = "Hello, world!\nGoodbye!\n"
+	type testCase struct {
+		actuals     []any
+		path        string
+		normalizers []Normalizer
+		verifier    TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"String matches": {
+			actuals:  []any{greeting},
+			path:     "testdata/golden/greeting.golden",
+			verifier: SuccessVerifier(),
+		},
+		"Text types match": {
+			actuals:  []any{[]byte(greeting), Ptr(greeting), bytes.NewBufferString(greeting), strings.NewReader(greeting)},
+			path:     "testdata/golden/greeting.golden",
+			verifier: SuccessVerifier(),
+		},
+		"Function matches": {
+			actuals:  []any{func() (string, error) { return greeting, nil }},
+			path:     "testdata/golden/greeting.golden",
+			verifier: SuccessVerifier(),
+		},
+		"Mismatch shows a diff": {
+			actuals: []any{"Hello, there!\nGoodbye!\n"},
+			path:    "testdata/golden/greeting.golden",
+			verifier: FailureVerifier(regexp.QuoteMeta("" +
+				"Expected actual value to match golden file 'testdata/golden/greeting.golden' (set JUSTEST_UPDATE_GOLDEN_FILES=1 to update it), but it differs:\n" +
+				"--- testdata/golden/greeting.golden\n" +
+				"+++ actual\n" +
+				"@@ -1,2 +1,2 @@\n" +
+				"-Hello, world!\n" +
+				"+Hello, there!\n" +
+				" Goodbye!",
+			)),
+		},
+		"Line endings differ": {
+			actuals:  []any{strings.ReplaceAll(greeting, "\n", "\r\n")},
+			path:     "testdata/golden/greeting.golden",
+			verifier: FailureVerifier(`Expected actual value to match golden file`),
+		},
+		"Line endings normalized": {
+			actuals:     []any{strings.ReplaceAll(greeting, "\n", "\r\n")},
+			path:        "testdata/golden/greeting.golden",
+			normalizers: []Normalizer{NormalizeLineEndings()},
+			verifier:    SuccessVerifier(),
+		},
+		"Trailing whitespace normalized": {
+			actuals:     []any{"Hello, world!  \nGoodbye!\t\n"},
+			path:        "testdata/golden/greeting.golden",
+			normalizers: []Normalizer{NormalizeTrailingWhitespace()},
+			verifier:    SuccessVerifier(),
+		},
+		"Custom matches normalized": {
+			actuals:     []any{"Hello, gopher!\nGoodbye!\n"},
+			path:        "testdata/golden/greeting.golden",
+			normalizers: []Normalizer{NormalizeMatches(regexp.MustCompile(`Hello, \w+!`), "Hello, someone!")},
+			verifier:    SuccessVerifier(),
+		},
+		"Missing golden file": {
+			actuals:  []any{greeting},
+			path:     "testdata/golden/missing.golden",
+			verifier: FailureVerifier(`Golden file 'testdata/golden/missing.golden' does not exist \(set JUSTEST_UPDATE_GOLDEN_FILES=1 to create it\)`),
+		},
+		"Unsupported actual": {
+			actuals:  []any{[]int{1, 2}},
+			path:     "testdata/golden/greeting.golden",
+			verifier: FailureVerifier(`Unsupported type '\[\]int' for MatchGoldenFile matcher: \[1 2\]`),
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actuals...).Will(MatchGoldenFile(tc.path, tc.normalizers...)).Now()
+		})
+	}
+}
+
+func TestMatchGoldenFileTimestampsAndTempDirs(t *testing.T) {
+	t.Parallel()
+	report := "Wrote " + filepath.Join(t.TempDir(), "report.txt") + " at " + time.Now().Format(time.RFC3339Nano) + "\n"
+	With(t).VerifyThat(report).Will(MatchGoldenFile("testdata/golden/report.golden", NormalizeTempDirs(), NormalizeTimestamps())).Now()
+}
+
+func TestMatchGoldenFileUpdate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "output.golden")
+
+	t.Setenv(UpdateGoldenFilesEnvVarName, "1")
+	With(t).VerifyThat("first version\r\n").Will(MatchGoldenFile(path, NormalizeLineEndings())).Now()
+	With(t).VerifyThat(os.ReadFile(path)).Will(EqualTo([]byte("first version\n"), nil)).Now()
+	With(t).VerifyThat("second version\n").Will(MatchGoldenFile(path)).Now()
+
+	t.Setenv(UpdateGoldenFilesEnvVarName, "")
+	With(t).VerifyThat("second version\n").Will(MatchGoldenFile(path)).Now()
+}
@@ -0,0 +1,197 @@
+package justest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	jsonValueExtractor  ValueExtractor
+	jsonIdentifierRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+)
+
+func init() {
+	jsonValueExtractor = NewValueExtractor(ExtractSameValue)
+	jsonValueExtractor[reflect.Chan] = NewChannelExtractor(jsonValueExtractor, true)
+	jsonValueExtractor[reflect.Func] = NewFuncExtractor(jsonValueExtractor, true)
+	jsonValueExtractor[reflect.Pointer] = func(t T, v any) (any, bool) {
+		GetHelper(t).Helper()
+		switch value := v.(type) {
+		case *bytes.Buffer, *string, *[]byte:
+			return v, true
+		case *Buffer:
+			return value.Contents(), true
+		case io.Reader:
+			// Readers are drained into a buffer, so that every attempt of timed assertions sees all content read so far
+			return readerBuffer(t, value).Contents(), true
+		default:
+			return v, true
+		}
+	}
+}
+
+// parseJSON returns the generic representation (maps, slices, json.Number, etc.) of the given JSON document, which
+// can be a string, a []byte, a json.RawMessage, an io.Reader (or pointers to any of these), or any other value, which
+// is then marshalled to JSON first.
+func parseJSON(v any) (any, error) {
+	var data []byte
+	switch d := v.(type) {
+	case string:
+		data = []byte(d)
+	case *string:
+		data = []byte(*d)
+	case []byte:
+		data = d
+	case *[]byte:
+		data = *d
+	case json.RawMessage:
+		data = d
+	case *bytes.Buffer:
+		data = d.Bytes()
+	case io.Reader:
+		b, err := io.ReadAll(d)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading JSON: %w", err)
+		}
+		data = b
+	default:
+		b, err := json.Marshal(d)
+		if err != nil {
+			return nil, fmt.Errorf("failed marshalling '%T' to JSON: %w", d, err)
+		}
+		data = b
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	} else if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid JSON: unexpected data after top-level value")
+	}
+	return doc, nil
+}
+
+// formatJSON returns the compact JSON representation of the given generic JSON value.
+func formatJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%+v", v)
+	}
+	return string(b)
+}
+
+// jsonPathOfKey returns the JSON path of the given object key under the given parent path.
+func jsonPathOfKey(parent, key string) string {
+	if jsonIdentifierRegex.MatchString(key) {
+		return parent + "." + key
+	}
+	return parent + "[" + strconv.Quote(key) + "]"
+}
+
+// jsonNumbersEqual returns true if the given JSON numbers are numerically equal (e.g. "1" and "1.0").
+func jsonNumbersEqual(a, b json.Number) bool {
+	ra, okA := new(big.Rat).SetString(string(a))
+	rb, okB := new(big.Rat).SetString(string(b))
+	if !okA || !okB {
+		return a == b
+	}
+	return ra.Cmp(rb) == 0
+}
+
+// diffJSON returns the differences between the given generic JSON values, each annotated with its JSON path.
+func diffJSON(path string, expected, actual any) []string {
+	switch e := expected.(type) {
+	case map[string]any:
+		if a, ok := actual.(map[string]any); ok {
+			keys := make([]string, 0, len(e)+len(a))
+			for k := range e {
+				keys = append(keys, k)
+			}
+			for k := range a {
+				if _, ok := e[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+
+			var diffs []string
+			for _, k := range keys {
+				ev, inExpected := e[k]
+				av, inActual := a[k]
+				switch {
+				case !inActual:
+					diffs = append(diffs, fmt.Sprintf("%s: expected %s, got nothing", jsonPathOfKey(path, k), formatJSON(ev)))
+				case !inExpected:
+					diffs = append(diffs, fmt.Sprintf("%s: expected nothing, got %s", jsonPathOfKey(path, k), formatJSON(av)))
+				default:
+					diffs = append(diffs, diffJSON(jsonPathOfKey(path, k), ev, av)...)
+				}
+			}
+			return diffs
+		}
+	case []any:
+		if a, ok := actual.([]any); ok {
+			var diffs []string
+			for i := 0; i < max(len(e), len(a)); i++ {
+				elementPath := fmt.Sprintf("%s[%d]", path, i)
+				switch {
+				case i >= len(a):
+					diffs = append(diffs, fmt.Sprintf("%s: expected %s, got nothing", elementPath, formatJSON(e[i])))
+				case i >= len(e):
+					diffs = append(diffs, fmt.Sprintf("%s: expected nothing, got %s", elementPath, formatJSON(a[i])))
+				default:
+					diffs = append(diffs, diffJSON(elementPath, e[i], a[i])...)
+				}
+			}
+			return diffs
+		}
+	case json.Number:
+		if a, ok := actual.(json.Number); ok && jsonNumbersEqual(e, a) {
+			return nil
+		}
+	default:
+		if expected == actual {
+			return nil
+		}
+	}
+	return []string{fmt.Sprintf("%s: expected %s, got %s", path, formatJSON(expected), formatJSON(actual))}
+}
+
+// MatchJSON returns a matcher that checks that all given actual values are JSON documents semantically equivalent to
+// the given expected document, regardless of key order or whitespace. Both expected and actual values can be a
+// string, a []byte, a json.RawMessage, an io.Reader, or any other value that can be marshalled to JSON; actual values
+// can also be functions or channels providing such values. Actual readers are drained into a Buffer (like in Say), so
+// that timed assertions (e.g. Within) match all content read from them so far, rather than consuming it.
+//
+//go:noinline
+func MatchJSON(expected any) Matcher {
+	expectedDoc, err := parseJSON(expected)
+	if err != nil {
+		panic(fmt.Sprintf("unsupported expected value for MatchJSON matcher: %v", err))
+	}
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			v := jsonValueExtractor.MustExtractValue(t, actual)
+			actualDoc, err := parseJSON(v)
+			if err != nil {
+				t.Fatalf("Unsupported actual value for MatchJSON matcher: %v", err)
+			}
+			if diffs := diffJSON("$", expectedDoc, actualDoc); len(diffs) > 0 {
+				t.Fatalf("Expected JSON to match %s, but it differs:\n%s", formatJSON(expectedDoc), strings.Join(diffs, "\n"))
+			}
+		}
+	})
+	return Described(m, "to match JSON "+formatJSON(expectedDoc), "not to match JSON "+formatJSON(expectedDoc))
+}
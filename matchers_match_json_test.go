@@ -0,0 +1,124 @@
+package justest_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/internal"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestMatchJSON(t *testing.T) {
+	t.Parallel()
+	type item struct {
+		Name  string `json:"name"`
+		Price int    `json:"price"`
+	}
+	type testCase struct {
+		actual   any
+		expected any
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Equal strings":                 {actual: `{"a":1,"b":[1,2]}`, expected: `{"a":1,"b":[1,2]}`, verifier: SuccessVerifier()},
+		"Key order and whitespace":      {actual: `{"b": [1, 2],  "a": 1}`, expected: "{\n  \"a\": 1,\n  \"b\": [1,2]\n}", verifier: SuccessVerifier()},
+		"Equivalent numbers":            {actual: `{"a":1.0}`, expected: `{"a":1}`, verifier: SuccessVerifier()},
+		"[]byte actual":                 {actual: []byte(`{"a":1}`), expected: `{"a":1}`, verifier: SuccessVerifier()},
+		"*[]byte actual":                {actual: Ptr([]byte(`{"a":1}`)), expected: `{"a":1}`, verifier: SuccessVerifier()},
+		"*string actual":                {actual: Ptr(`{"a":1}`), expected: `{"a":1}`, verifier: SuccessVerifier()},
+		"json.RawMessage actual":        {actual: json.RawMessage(`{"a":1}`), expected: `{"a":1}`, verifier: SuccessVerifier()},
+		"io.Reader actual":              {actual: strings.NewReader(`{"a":1}`), expected: []byte(`{"a":1}`), verifier: SuccessVerifier()},
+		"*bytes.Buffer actual":          {actual: bytes.NewBufferString(`{"a":1}`), expected: `{"a":1}`, verifier: SuccessVerifier()},
+		"Marshallable actual":           {actual: item{Name: "x", Price: 10}, expected: `{"price":10,"name":"x"}`, verifier: SuccessVerifier()},
+		"Marshallable expected":         {actual: `{"name":"x","price":10}`, expected: &item{Name: "x", Price: 10}, verifier: SuccessVerifier()},
+		"Function actual":               {actual: func() ([]byte, error) { return []byte(`[1,2]`), nil }, expected: `[1,2]`, verifier: SuccessVerifier()},
+		"Failing function actual fails": {actual: func() ([]byte, error) { return nil, fmt.Errorf("boom") }, expected: `[1,2]`, verifier: FailureVerifier(`Function failed: boom`)},
+		"Nested value differs": {
+			actual:   `{"items":[{"price":1},{"price":5},{"price":12}]}`,
+			expected: `{"items":[{"price":1},{"price":5},{"price":10}]}`,
+			verifier: FailureVerifier(`Expected JSON to match .+, but it differs:\n` + regexp.QuoteMeta(`$.items[2].price: expected 10, got 12`)),
+		},
+		"Missing and extra keys": {
+			actual:   `{"b":2,"c":3,"odd key":true}`,
+			expected: `{"a":1,"b":2}`,
+			verifier: FailureVerifier(regexp.QuoteMeta("$.a: expected 1, got nothing\n$.c: expected nothing, got 3\n$[\"odd key\"]: expected nothing, got true")),
+		},
+		"Array length differs": {
+			actual:   `[1,2,3]`,
+			expected: `[1,2]`,
+			verifier: FailureVerifier(regexp.QuoteMeta(`$[2]: expected nothing, got 3`)),
+		},
+		"Type differs": {
+			actual:   `{"a":"1"}`,
+			expected: `{"a":1}`,
+			verifier: FailureVerifier(regexp.QuoteMeta(`$.a: expected 1, got "1"`)),
+		},
+		"Invalid actual fails": {
+			actual:   `{"a":`,
+			expected: `{"a":1}`,
+			verifier: FailureVerifier(`Unsupported actual value for MatchJSON matcher: invalid JSON: unexpected EOF`),
+		},
+		"Trailing data fails": {
+			actual:   `{"a":1} {}`,
+			expected: `{"a":1}`,
+			verifier: FailureVerifier(`Unsupported actual value for MatchJSON matcher: invalid JSON: unexpected data after top-level value`),
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(MatchJSON(tc.expected)).Now()
+		})
+	}
+}
+
+func TestMatchJSONNegated(t *testing.T) {
+	t.Parallel()
+	mt := NewMockT(t)
+	defer mt.Verify(FailureVerifier(regexp.QuoteMeta(`not to match JSON {"a":1}`)))
+	With(mt).VerifyThat(`{ "a": 1 }`).Will(Not(MatchJSON(`{"a":1}`))).Now()
+}
+
+func TestMatchJSONInvalidExpected(t *testing.T) {
+	t.Parallel()
+	With(t).VerifyThat(func() { MatchJSON(`{"a":`) }).Will(PanicWith(Say(`^unsupported expected value for MatchJSON matcher: invalid JSON`))).Now()
+}
+
+func TestMatchJSONPolledReader(t *testing.T) {
+	t.Parallel()
+	t.Run("Reader is not consumed by failed attempts", func(t *testing.T) {
+		t.Parallel()
+		attempts := 0
+		With(t).VerifyThat(strings.NewReader(`{"a": 1}`)).Will(AllOf(
+			MatchJSON(`{"a":1}`),
+			MatcherFunc(func(t T, actuals ...any) {
+				if attempts++; attempts < 3 {
+					t.Fatalf("attempt %d failed", attempts)
+				}
+			}),
+		)).Within(5*time.Second, 10*time.Millisecond)
+	})
+	t.Run("Content arriving later is matched", func(t *testing.T) {
+		t.Parallel()
+		r, w, err := os.Pipe()
+		With(t).VerifyThat(err).Will(BeNil()).Now()
+		defer r.Close()
+		go func() {
+			defer w.Close()
+			_, _ = w.Write([]byte(`{"a":`))
+			time.Sleep(50 * time.Millisecond)
+			_, _ = w.Write([]byte(` 1}`))
+		}()
+		With(t).VerifyThat(r).Will(MatchJSON(`{"a":1}`)).Within(5*time.Second, 10*time.Millisecond)
+	})
+}
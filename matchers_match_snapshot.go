@@ -0,0 +1,102 @@
+package justest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/arikkfir/justest/internal"
+)
+
+var (
+	snapshotValueExtractor ValueExtractor
+)
+
+func init() {
+	snapshotValueExtractor = NewValueExtractor(ExtractSameValue)
+	snapshotValueExtractor[reflect.Chan] = NewChannelExtractor(snapshotValueExtractor, true)
+	snapshotValueExtractor[reflect.Func] = NewFuncExtractor(snapshotValueExtractor, true)
+}
+
+// formatSnapshot returns the snapshot text of the given value: strings, byte slices and buffers are used as-is, and
+// other values are rendered as indented JSON (or using "%+v" if they cannot be marshalled).
+func formatSnapshot(v any) string {
+	switch value := v.(type) {
+	case string:
+		return value
+	case *string:
+		return *value
+	case []byte:
+		return string(value)
+	case *[]byte:
+		return string(*value)
+	case *bytes.Buffer:
+		return value.String()
+	}
+	if b, err := json.MarshalIndent(v, "", "  "); err == nil {
+		return string(b)
+	}
+	return fmt.Sprintf("%+v", v)
+}
+
+//go:noinline
+func matchSnapshot(store *snapshotStore) Matcher {
+	var mu sync.Mutex
+	keys := make(map[testing.TB]string)
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+
+		// Keep using the same key when the matcher is re-applied in the same test (e.g. by timed assertions)
+		root := GetRoot(t)
+		mu.Lock()
+		key, ok := keys[root]
+		if !ok {
+			key = store.nextKey(root)
+			keys[root] = key
+			root.Cleanup(func() {
+				mu.Lock()
+				defer mu.Unlock()
+				delete(keys, root)
+			})
+		}
+		mu.Unlock()
+
+		values := make([]string, len(actuals))
+		for i, actual := range actuals {
+			values[i] = formatSnapshot(snapshotValueExtractor.MustExtractValue(t, actual))
+		}
+		actual := strings.Join(values, "\n")
+
+		expected, result, err := store.check(key, actual)
+		switch {
+		case err != nil:
+			t.Fatalf("Failed checking snapshot '%s': %v", key, err)
+		case result == snapshotCreated:
+			t.Logf("Created snapshot '%s'", key)
+		case result == snapshotUpdated:
+			t.Logf("Updated snapshot '%s'", key)
+		case result == snapshotMismatched:
+			diff := internal.UnifiedDiff("snapshot", "actual", expected, actual, 3)
+			t.Fatalf("Expected actual value to match snapshot '%s' (set %s=1 to update it), but it differs:\n%s", key, UpdateSnapshotsEnvVarName, strings.TrimSuffix(diff, "\n"))
+		}
+	})
+	return Described(m, "to match snapshot", "not to match snapshot")
+}
+
+// MatchSnapshot returns a matcher that checks that the given actual values match their snapshot, stored in the
+// "testdata/__snapshots__/<TopLevelTestName>.snap" file of the package, keyed by the test name and a per-test counter
+// (e.g. "TestRender/html 2" for the second snapshot of the "TestRender/html" subtest). Missing snapshots are created,
+// and mismatching snapshots are reported with a diff, or rewritten if the JUSTEST_UPDATE_SNAPSHOTS environment variable
+// is set. Strings, byte slices and buffers are stored as-is, while other values are stored as indented JSON.
+//
+// Use RunWithSnapshots in TestMain to detect snapshots that are no longer checked by any test.
+//
+//go:noinline
+func MatchSnapshot() Matcher {
+	return matchSnapshot(defaultSnapshots)
+}
@@ -0,0 +1,65 @@
+package justest_test
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"time"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestMatchSnapshot(t *testing.T) {
+	t.Parallel()
+	type payload struct {
+		Name  string   `json:"name"`
+		Ports []int    `json:"ports"`
+		Tags  []string `json:"tags,omitempty"`
+	}
+	type testCase struct {
+		actuals []any
+	}
+	testCases := map[string]testCase{
+		"String":          {actuals: []any{"Usage: tool [flags]\n\n  -v\tverbose\n"}},
+		"Bytes":           {actuals: []any{[]byte("package main\n\nfunc main() {}\n")}},
+		"Buffer":          {actuals: []any{bytes.NewBufferString("buffered output")}},
+		"Struct":          {actuals: []any{payload{Name: "svc", Ports: []int{80, 443}}}},
+		"Function":        {actuals: []any{func() (string, error) { return "from a function", nil }}},
+		"Multiple values": {actuals: []any{"first", "second"}},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			With(t).VerifyThat(tc.actuals...).Will(MatchSnapshot()).Now()
+		})
+	}
+}
+
+func TestMatchSnapshotCounter(t *testing.T) {
+	t.Parallel()
+	With(t).VerifyThat("first snapshot").Will(MatchSnapshot()).Now()
+	With(t).VerifyThat("second snapshot").Will(MatchSnapshot()).Now()
+
+	// Timed assertions re-apply the same matcher, which keeps using the same snapshot
+	attempts := 0
+	With(t).VerifyThat(func() string { attempts++; return "third snapshot" }).Will(MatchSnapshot()).For(30*time.Millisecond, 10*time.Millisecond)
+	With(t).VerifyThat(attempts).Will(BeGreaterThan(1)).Now()
+}
+
+func TestMatchSnapshotMismatch(t *testing.T) {
+	t.Setenv(UpdateSnapshotsEnvVarName, "")
+	mt := NewMockT(t)
+	defer mt.Verify(FailureVerifier(regexp.QuoteMeta("" +
+		"Expected actual value to match snapshot 'TestMatchSnapshotMismatch 1' (set JUSTEST_UPDATE_SNAPSHOTS=1 to update it), but it differs:\n" +
+		"--- snapshot\n" +
+		"+++ actual\n" +
+		"@@ -1,3 +1,3 @@\n" +
+		" line 1\n" +
+		"-line 2\n" +
+		"+line two\n" +
+		" line 3",
+	)))
+	With(mt).VerifyThat("line 1\nline two\nline 3\n").Will(MatchSnapshot()).Now()
+}
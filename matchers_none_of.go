@@ -0,0 +1,36 @@
+package justest
+
+import (
+	"strconv"
+	"strings"
+)
+
+//go:noinline
+func NoneOf(matchers ...Matcher) Matcher {
+	if len(matchers) == 0 {
+		panic("expected at least one matcher")
+	}
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+
+		var matched []string
+		for i, matcher := range matchers {
+			if failure := tryAssert(t, matcher, actuals...); failure == nil {
+				matched = append(matched, "#"+strconv.Itoa(i+1))
+			}
+		}
+
+		if len(matched) > 0 {
+			t.Fatalf("Expected none of %d matchers to match, but matchers %s did", len(matchers), strings.Join(matched, ", "))
+		}
+	})
+
+	descriptions := make([]string, len(matchers))
+	negations := make([]string, len(matchers))
+	for i, matcher := range matchers {
+		descriptions[i] = describe(matcher)
+		negations[i] = describeNegation(matcher)
+	}
+	return Described(m, strings.Join(negations, " and "), strings.Join(descriptions, " or "))
+}
@@ -0,0 +1,51 @@
+package justest_test
+
+import (
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestNoneOf(t *testing.T) {
+	t.Parallel()
+	succeeding := MatcherFunc(func(t T, actuals ...any) {})
+	failing := MatcherFunc(func(t T, actuals ...any) { t.Fatalf("failure"); panic("unreachable") })
+	type testCase struct {
+		actuals  []any
+		matchers []Matcher
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"No matcher matches": {
+			actuals:  []any{5},
+			matchers: []Matcher{failing, BeGreaterThan(10), EqualTo(6)},
+			verifier: SuccessVerifier(),
+		},
+		"Matching matchers are reported": {
+			actuals:  []any{5},
+			matchers: []Matcher{succeeding, failing, BeLessThan(10)},
+			verifier: FailureVerifier(`^Expected none of 3 matchers to match, but matchers #1, #3 did\n`),
+		},
+		"Panicking matcher re-panics": {
+			actuals:  []any{5},
+			matchers: []Matcher{MatcherFunc(func(t T, actual ...any) { panic("panic propagated") })},
+			verifier: PanicVerifier(`panic propagated`),
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actuals...).Will(NoneOf(tc.matchers...)).Now()
+		})
+	}
+	t.Run("Panics without matchers", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(PanicVerifier(`expected at least one matcher`))
+		NoneOf()
+	})
+}
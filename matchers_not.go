@@ -49,30 +49,46 @@ func (t *inverseT) GetParent() T {
 	return t.parent
 }
 
+// tryAssert invokes the given matcher with a T that captures its failure instead of propagating it, and returns that
+// failure, or nil if the matcher succeeded. Panics other than the matcher's failure are propagated.
+//
 //go:noinline
-func Not(m Matcher) Matcher {
-	return MatcherFunc(func(t T, actuals ...any) {
+func tryAssert(t T, m Matcher, actuals ...any) (failure *FormatAndArgs) {
+	GetHelper(t).Helper()
+
+	tt := &inverseT{parent: t}
+
+	defer func() {
 		GetHelper(t).Helper()
+		if r := recover(); r != nil {
+			if r == tt {
+				// Matcher failed - return its failure
+				failure = tt.failure
+			} else if err, ok := r.(error); ok {
+				// Unexpected panic - bubble it up
+				panic(fmt.Errorf("unexpected panic: %w", err))
+			} else {
+				// Unexpected panic - bubble it up
+				panic(fmt.Errorf("unexpected panic: %+v", r))
+			}
+		}
+	}()
 
-		tt := &inverseT{parent: t}
+	m.Assert(tt, actuals...)
+	return nil
+}
 
-		defer func() {
-			GetHelper(t).Helper()
-			if r := recover(); r != nil {
-				if r == tt {
-					// Matcher failed - good!
-				} else if err, ok := r.(error); ok {
-					// Unexpected panic - bubble it up
-					panic(fmt.Errorf("unexpected panic: %w", err))
-				} else {
-					// Unexpected panic - bubble it up
-					panic(fmt.Errorf("unexpected panic: %+v", r))
-				}
+//go:noinline
+func Not(m Matcher) Matcher {
+	nm := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		if failure := tryAssert(t, m, actuals...); failure == nil {
+			if dm, ok := m.(DescribedMatcher); ok {
+				t.Fatalf("Expected %s %s", describeValues(actuals), dm.DescribeNegation())
 			} else {
 				t.Fatalf("Expected mismatch did not happen")
 			}
-		}()
-
-		m.Assert(tt, actuals...)
+		}
 	})
+	return Described(nm, describeNegation(m), describe(m))
 }
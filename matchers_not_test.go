@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 func TestNot(t *testing.T) {
@@ -38,4 +39,16 @@ func TestNot(t *testing.T) {
 			With(mt).VerifyThat(tc.actuals...).Will(Not(tc.matcher)).Now()
 		})
 	}
+	t.Run("Successful described matcher fails with description", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^Expected 5 not to be between 1 and 10\n`))
+		With(mt).VerifyThat(5).Will(Not(BeBetween(1, 10))).Now()
+	})
+	t.Run("Double negation is described", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^Expected "abc" to be empty\n`))
+		With(mt).VerifyThat("abc").Will(Not(Not(BeEmpty()))).Now()
+	})
 }
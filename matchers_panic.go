@@ -0,0 +1,82 @@
+package justest
+
+import (
+	"reflect"
+)
+
+// callAndRecover invokes the given function (which must accept either no arguments or a single T argument) and returns
+// the value it panicked with, if it panicked. Failures reported by the function through its T argument are propagated
+// to the given T.
+//
+//go:noinline
+func callAndRecover(t T, f any) (recovered any, panicked bool) {
+	GetHelper(t).Helper()
+
+	funcValue := reflect.ValueOf(f)
+	if funcValue.Kind() != reflect.Func {
+		t.Fatalf("Expected a function, but got '%T': %+v", f, f)
+	}
+	funcType := funcValue.Type()
+
+	tt := &inverseT{parent: t}
+	var in []reflect.Value
+	switch funcType.NumIn() {
+	case 0:
+		in = nil
+	case 1:
+		arg0Type := funcType.In(0)
+		if arg0Type.PkgPath() == tTypePkgPath && arg0Type.Name() == tTypeName {
+			in = append(in, reflect.ValueOf(tt))
+		} else {
+			t.Fatalf("Argument of functions with one argument must be of type T, found: %+v", arg0Type.Name())
+		}
+	default:
+		t.Fatalf("Functions with more than 1 input parameter are not supported in this context: %+v", f)
+	}
+
+	func() {
+		GetHelper(t).Helper()
+		defer func() {
+			recovered = recover()
+			panicked = recovered != nil && recovered != tt
+		}()
+		funcValue.Call(in)
+	}()
+
+	if recovered == tt {
+		t.Fatalf("Function failed instead of panicking: %s", tt.failure)
+	}
+	return recovered, panicked
+}
+
+//go:noinline
+func Panic() Matcher {
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			if _, panicked := callAndRecover(t, actual); !panicked {
+				t.Fatalf("Expected function to panic, but it did not")
+			}
+		}
+	})
+	return Described(m, "to panic", "not to panic")
+}
+
+//go:noinline
+func PanicWith(m Matcher) Matcher {
+	if m == nil {
+		panic("expected a non-nil matcher")
+	}
+
+	pm := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			if recovered, panicked := callAndRecover(t, actual); !panicked {
+				t.Fatalf("Expected function to panic, but it did not")
+			} else {
+				m.Assert(t, recovered)
+			}
+		}
+	})
+	return Described(pm, "to panic with a value expected "+describe(m), "not to panic with a value expected "+describe(m))
+}
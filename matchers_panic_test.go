@@ -0,0 +1,76 @@
+package justest_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestPanic(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		actual   any
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Panicking function":         {actual: func() { panic("boom") }, verifier: SuccessVerifier()},
+		"Panicking function with T":  {actual: func(t T) { panic("boom") }, verifier: SuccessVerifier()},
+		"Panicking function returns": {actual: func() (int, error) { panic("boom") }, verifier: SuccessVerifier()},
+		"Non-panicking function":     {actual: func() {}, verifier: FailureVerifier(`^Expected function to panic, but it did not`)},
+		"Failing function":           {actual: func(t T) { t.Fatalf("failure") }, verifier: FailureVerifier(`^Function failed instead of panicking: failure`)},
+		"Non-function actual":        {actual: 1, verifier: FailureVerifier(`^Expected a function, but got 'int': 1`)},
+		"Unsupported function":       {actual: func(i int) {}, verifier: FailureVerifier(`^Argument of functions with one argument must be of type T, found: int`)},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(Panic()).Now()
+		})
+	}
+	t.Run("Works within timed assertions", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		invocations := 0
+		f := func() {
+			invocations++
+			if invocations >= 3 {
+				panic("boom")
+			}
+		}
+		With(mt).VerifyThat(f).Will(Panic()).Within(5*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestPanicWith(t *testing.T) {
+	t.Parallel()
+	errBoom := errors.New("boom")
+	type testCase struct {
+		actual   any
+		matcher  Matcher
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Matching value":         {actual: func() { panic("boom") }, matcher: EqualTo("boom"), verifier: SuccessVerifier()},
+		"Mismatching value":      {actual: func() { panic("bang") }, matcher: EqualTo("boom"), verifier: FailureVerifier(`^Unexpected difference`)},
+		"Matching error":         {actual: func() { panic(fmt.Errorf("wrapped: %w", errBoom)) }, matcher: MatchError(errBoom), verifier: SuccessVerifier()},
+		"Mismatching error":      {actual: func() { panic(errors.New("bang")) }, matcher: MatchError(errBoom), verifier: FailureVerifier(`^Expected error 'bang' to match 'boom' \(using errors\.Is\), but it does not`)},
+		"Non-panicking function": {actual: func() {}, matcher: EqualTo("boom"), verifier: FailureVerifier(`^Expected function to panic, but it did not`)},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(tc.actual).Will(PanicWith(tc.matcher)).Now()
+		})
+	}
+}
@@ -3,6 +3,7 @@ package justest
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"reflect"
 	"regexp"
 )
@@ -12,61 +13,97 @@ var (
 )
 
 func init() {
-	sayValueExtractor = NewValueExtractor(ExtractorUnsupported)
-	sayValueExtractor[reflect.Chan] = NewChannelExtractor(sayValueExtractor, true)
-	sayValueExtractor[reflect.Func] = NewFuncExtractor(sayValueExtractor, true)
+	sayValueExtractor = newTextValueExtractor("Say")
+
+	// Buffers & readers are matched incrementally, so they are given to the matcher as a Buffer rather than as text
+	textPointerExtractor := sayValueExtractor[reflect.Pointer]
 	sayValueExtractor[reflect.Pointer] = func(t T, v any) (any, bool) {
 		GetHelper(t).Helper()
-		if bufferPointer, ok := v.(*bytes.Buffer); ok {
+		switch value := v.(type) {
+		case *Buffer:
+			return value, true
+		case *bytes.Buffer, *string, *[]byte:
+			return textPointerExtractor(t, v)
+		case io.Reader:
+			return readerBuffer(t, value), true
+		default:
+			return textPointerExtractor(t, v)
+		}
+	}
+}
+
+// newTextValueExtractor returns a value extractor that converts strings, byte slices, buffers (or pointers to these),
+// readers (whose content read so far is used, see readerBuffer), as well as functions or channels providing such
+// values, to strings; other values fail the given matcher.
+func newTextValueExtractor(matcherName string) ValueExtractor {
+	ve := NewValueExtractor(ExtractorUnsupported)
+	ve[reflect.Chan] = NewChannelExtractor(ve, true)
+	ve[reflect.Func] = NewFuncExtractor(ve, true)
+	ve[reflect.Pointer] = func(t T, v any) (any, bool) {
+		GetHelper(t).Helper()
+		if buffer, ok := v.(*Buffer); ok {
+			return buffer.String(), true
+		} else if bufferPointer, ok := v.(*bytes.Buffer); ok {
 			return bufferPointer.String(), true
 		} else if stringPointer, ok := v.(*string); ok {
 			return *stringPointer, true
 		} else if ba, ok := v.(*[]byte); ok {
 			return string(*ba), true
+		} else if r, ok := v.(io.Reader); ok {
+			return readerBuffer(t, r).String(), true
 		} else {
-			t.Fatalf("Unsupported type '%T' for Say matcher: %+v", v, v)
+			t.Fatalf("Unsupported type '%T' for %s matcher: %+v", v, matcherName, v)
 			panic("unreachable")
 		}
 	}
-	sayValueExtractor[reflect.Slice] = func(t T, v any) (any, bool) {
+	ve[reflect.Slice] = func(t T, v any) (any, bool) {
 		GetHelper(t).Helper()
 		if b, ok := v.([]byte); ok {
 			return string(b), true
 		} else {
-			t.Fatalf("Unsupported type '%T' for Say matcher: %+v", v, v)
+			t.Fatalf("Unsupported type '%T' for %s matcher: %+v", v, matcherName, v)
 			panic("unreachable")
 		}
 	}
-	sayValueExtractor[reflect.String] = ExtractSameValue
+	ve[reflect.String] = ExtractSameValue
+	return ve
 }
 
+// Say returns a matcher that checks that all given actual values match the given regular expression. Actual values can
+// be strings, byte slices, buffers (or pointers to these), or functions or channels providing such values.
+//
+// A Buffer is matched incrementally: only its content after the last match is matched, and a successful match advances
+// its read cursor past the matched text. Any other io.Reader is drained into such a Buffer on first use, so successive
+// assertions on the same reader continue from where the previous match ended. In-memory readers (e.g. strings.Reader)
+// are read right away, while other readers (e.g. pipes) are drained in the background, and only the content read so
+// far is matched; use timed assertions such as Within when matching streams.
+//
 //go:noinline
 func Say[Type string | *regexp.Regexp](expectation Type) Matcher {
+	var re *regexp.Regexp
 	switch e := any(expectation).(type) {
 	case string:
-		re := regexp.MustCompile(e)
-		return MatcherFunc(func(t T, actuals ...any) {
-			GetHelper(t).Helper()
-			for _, actual := range actuals {
-				v := sayValueExtractor.MustExtractValue(t, actual)
-				if !re.Match([]byte(v.(string))) {
-					t.Fatalf("Expected actual value to match '%s', but it does not: %s", re, v)
-				}
-			}
-		})
-
+		re = regexp.MustCompile(e)
 	case *regexp.Regexp:
-		return MatcherFunc(func(t T, actuals ...any) {
-			GetHelper(t).Helper()
-			for _, actual := range actuals {
-				v := sayValueExtractor.MustExtractValue(t, actual)
-				if !e.Match([]byte(v.(string))) {
-					t.Fatalf("Expected actual value to match '%s', but it does not: %s", e, v)
-				}
-			}
-		})
-
+		re = e
 	default:
 		panic(fmt.Sprintf("unsupported type for Say matcher: %T", expectation))
 	}
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			switch v := sayValueExtractor.MustExtractValue(t, actual).(type) {
+			case *Buffer:
+				if matched, unread := v.say(re); !matched {
+					t.Fatalf("Expected actual value to match '%s' after the last match, but it does not: %s", re, unread)
+				}
+			default:
+				if !re.Match([]byte(v.(string))) {
+					t.Fatalf("Expected actual value to match '%s', but it does not: %s", re, v)
+				}
+			}
+		}
+	})
+	return Described(m, fmt.Sprintf("to match '%s'", re), fmt.Sprintf("not to match '%s'", re))
 }
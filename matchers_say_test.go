@@ -2,11 +2,17 @@ package justest_test
 
 import (
 	"bytes"
+	"io"
+	"os"
 	"regexp"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	. "github.com/arikkfir/justest"
 	. "github.com/arikkfir/justest/internal"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 func TestSay(t *testing.T) {
@@ -34,3 +40,105 @@ func TestSay(t *testing.T) {
 		})
 	}
 }
+
+func TestSayBuffer(t *testing.T) {
+	t.Parallel()
+	t.Run("Matches content after the last match", func(t *testing.T) {
+		t.Parallel()
+		b := BufferWithBytes([]byte("starting\nlistening on :8080\nready\n"))
+		With(t).VerifyThat(b).Will(Say("listening on")).Now()
+		With(t).VerifyThat(b).Will(Say("ready")).Now()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`Expected actual value to match 'starting' after the last match, but it does not: \n`))
+		With(mt).VerifyThat(b).Will(Say("starting")).Now()
+	})
+	t.Run("Waits for successive writes", func(t *testing.T) {
+		t.Parallel()
+		b := NewBuffer()
+		go func() {
+			for _, line := range []string{"first\n", "second\n", "third\n"} {
+				time.Sleep(10 * time.Millisecond)
+				_, _ = b.Write([]byte(line))
+			}
+		}()
+		With(t).VerifyThat(b).Will(Say("^first\n")).Within(time.Second, 5*time.Millisecond)
+		With(t).VerifyThat(b).Will(Say("^second\n")).Within(time.Second, 5*time.Millisecond)
+		With(t).VerifyThat(b).Will(Say("^third\n")).Within(time.Second, 5*time.Millisecond)
+	})
+	t.Run("Drains readers incrementally", func(t *testing.T) {
+		t.Parallel()
+		r, w, err := os.Pipe()
+		With(t).VerifyThat(err).Will(BeNil()).Now()
+		defer r.Close()
+		defer w.Close()
+		go func() { _, _ = w.Write([]byte("hello ")) }()
+		With(t).VerifyThat(r).Will(Say("hello")).Within(time.Second, 5*time.Millisecond)
+		go func() { _, _ = w.Write([]byte("world")) }()
+		With(t).VerifyThat(r).Will(Say("^ world$")).Within(time.Second, 5*time.Millisecond)
+	})
+	t.Run("Reads in-memory readers right away", func(t *testing.T) {
+		t.Parallel()
+		for i := 0; i < 100; i++ {
+			r := strings.NewReader("hello world")
+			With(t).VerifyThat(r).Will(Say("hello")).Within(time.Second, 5*time.Millisecond)
+			With(t).VerifyThat(r).Will(Say("^ world$")).Now()
+		}
+	})
+	t.Run("Reader buffers are forgotten when the test ends", func(t *testing.T) {
+		t.Parallel()
+		r := &rewindableReader{data: "hello"}
+		t.Run("Consumes the reader", func(t *testing.T) {
+			With(t).VerifyThat(r).Will(Say("hello")).Now()
+		})
+		r.rewind()
+		With(t).VerifyThat(r).Will(Say("^hello$")).Now()
+	})
+	t.Run("Does not block on readers without content", func(t *testing.T) {
+		t.Parallel()
+		r, w := io.Pipe()
+		defer w.Close()
+		start := time.Now()
+		mt := NewMockT(t)
+		func() {
+			defer mt.Verify(FailureVerifier(`Expected actual value to match 'hello' after the last match, but it does not`))
+			With(mt).VerifyThat(r).Will(Say("hello")).Now()
+		}()
+		With(t).VerifyThat(time.Since(start)).Will(BeLessThan(time.Second)).Now()
+	})
+	t.Run("Functions providing buffers", func(t *testing.T) {
+		t.Parallel()
+		b := BufferWithBytes([]byte("a b"))
+		With(t).VerifyThat(func() *Buffer { return b }).Will(Say("a")).Now()
+		With(t).VerifyThat(func() *Buffer { return b }).Will(Say("^ b$")).Now()
+	})
+}
+
+// rewindableReader is a thread-safe, in-memory reader of a string, which can be rewound to its start.
+type rewindableReader struct {
+	mu     sync.Mutex
+	data   string
+	offset int
+}
+
+func (r *rewindableReader) Read(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.offset >= len(r.data) {
+		return 0, io.EOF
+	}
+	n := copy(p, r.data[r.offset:])
+	r.offset += n
+	return n, nil
+}
+
+func (r *rewindableReader) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.data) - r.offset
+}
+
+func (r *rewindableReader) rewind() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.offset = 0
+}
@@ -15,7 +15,7 @@ func init() {
 
 //go:noinline
 func Succeed() Matcher {
-	return MatcherFunc(func(t T, actuals ...any) {
+	m := MatcherFunc(func(t T, actuals ...any) {
 		GetHelper(t).Helper()
 
 		resolvedActuals := make([]any, 0, len(actuals))
@@ -41,4 +41,5 @@ func Succeed() Matcher {
 			t.Fatalf("Error occurred: %+v", last)
 		}
 	})
+	return Described(m, "to succeed", "not to succeed")
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 func TestSucceed(t *testing.T) {
@@ -0,0 +1,104 @@
+package justest
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// PollingStrategy determines how long timed assertions (For and Within) wait before each attempt, and how many attempts
+// they make.
+type PollingStrategy interface {
+	// Next returns the interval to wait before the given attempt (starting at 1), and whether that attempt should be
+	// made at all. Once it returns false, the assertion stops polling.
+	Next(attempt int) (time.Duration, bool)
+}
+
+// PollingStrategyFunc is a function that implements PollingStrategy.
+type PollingStrategyFunc func(attempt int) (time.Duration, bool)
+
+func (f PollingStrategyFunc) Next(attempt int) (time.Duration, bool) { return f(attempt) }
+
+// FixedInterval returns a PollingStrategy that waits the same interval before every attempt.
+//
+//go:noinline
+func FixedInterval(interval time.Duration) PollingStrategy {
+	if interval < 0 {
+		panic(fmt.Sprintf("expected a non-negative interval, got: %s", interval))
+	}
+	return PollingStrategyFunc(func(int) (time.Duration, bool) { return interval, true })
+}
+
+// ExponentialBackoff returns a PollingStrategy that waits the given initial interval before the first attempt, and
+// multiplies the interval by the given factor for each subsequent attempt, up to the given maximum interval. A maximum
+// of zero means the interval is not capped.
+//
+//go:noinline
+func ExponentialBackoff(initial time.Duration, factor float64, max time.Duration) PollingStrategy {
+	if initial < 0 {
+		panic(fmt.Sprintf("expected a non-negative initial interval, got: %s", initial))
+	} else if factor < 1 {
+		panic(fmt.Sprintf("expected a factor of at least 1, got: %v", factor))
+	} else if max < 0 {
+		panic(fmt.Sprintf("expected a non-negative maximum interval, got: %s", max))
+	}
+	return PollingStrategyFunc(func(attempt int) (time.Duration, bool) {
+		interval := float64(initial) * math.Pow(factor, float64(attempt-1))
+		if max > 0 && interval > float64(max) {
+			return max, true
+		} else if interval > math.MaxInt64 {
+			return time.Duration(math.MaxInt64), true
+		}
+		return time.Duration(interval), true
+	})
+}
+
+// WithJitter returns a PollingStrategy that randomly shifts each interval of the given strategy by up to the given
+// fraction of it, in either direction; e.g. a fraction of 0.1 turns an interval of 100ms into one between 90ms and
+// 110ms.
+//
+//go:noinline
+func WithJitter(strategy PollingStrategy, fraction float64) PollingStrategy {
+	if strategy == nil {
+		panic("given polling strategy must not be nil")
+	} else if fraction < 0 || fraction > 1 {
+		panic(fmt.Sprintf("expected a jitter fraction between 0 and 1, got: %v", fraction))
+	}
+	return PollingStrategyFunc(func(attempt int) (time.Duration, bool) {
+		interval, ok := strategy.Next(attempt)
+		if !ok {
+			return interval, false
+		}
+		return interval + time.Duration(float64(interval)*fraction*(2*rand.Float64()-1)), true
+	})
+}
+
+// MaxAttempts returns a PollingStrategy that follows the given strategy, but makes at most the given number of
+// attempts.
+//
+//go:noinline
+func MaxAttempts(strategy PollingStrategy, attempts int) PollingStrategy {
+	if strategy == nil {
+		panic("given polling strategy must not be nil")
+	} else if attempts < 1 {
+		panic(fmt.Sprintf("expected at least one attempt, got: %d", attempts))
+	}
+	return PollingStrategyFunc(func(attempt int) (time.Duration, bool) {
+		if attempt > attempts {
+			return 0, false
+		}
+		return strategy.Next(attempt)
+	})
+}
+
+// slowedBy returns a PollingStrategy that scales the intervals of the given strategy by the given slow factor.
+func slowedBy(strategy PollingStrategy, factor float64) PollingStrategy {
+	if factor == 1 {
+		return strategy
+	}
+	return PollingStrategyFunc(func(attempt int) (time.Duration, bool) {
+		interval, ok := strategy.Next(attempt)
+		return scaleDuration(interval, factor), ok
+	})
+}
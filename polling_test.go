@@ -0,0 +1,150 @@
+package justest_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestPollingStrategies(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		strategy  PollingStrategy
+		intervals []time.Duration
+		attempts  int
+		limited   bool
+	}
+	testCases := map[string]testCase{
+		"Fixed interval": {
+			strategy:  FixedInterval(100 * time.Millisecond),
+			intervals: []time.Duration{100 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond},
+			attempts:  3,
+		},
+		"Exponential backoff": {
+			strategy:  ExponentialBackoff(100*time.Millisecond, 2, 0),
+			intervals: []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond},
+			attempts:  4,
+		},
+		"Exponential backoff is capped": {
+			strategy:  ExponentialBackoff(100*time.Millisecond, 3, time.Second),
+			intervals: []time.Duration{100 * time.Millisecond, 300 * time.Millisecond, 900 * time.Millisecond, time.Second, time.Second},
+			attempts:  5,
+		},
+		"Max attempts": {
+			strategy:  MaxAttempts(FixedInterval(time.Second), 2),
+			intervals: []time.Duration{time.Second, time.Second},
+			attempts:  2,
+			limited:   true,
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			for i := 0; i < tc.attempts; i++ {
+				interval, ok := tc.strategy.Next(i + 1)
+				if !ok {
+					t.Fatalf("Expected attempt %d to be allowed", i+1)
+				} else if interval != tc.intervals[i] {
+					t.Fatalf("Expected interval %s before attempt %d, got %s", tc.intervals[i], i+1, interval)
+				}
+			}
+			if _, ok := tc.strategy.Next(tc.attempts + 1); ok == tc.limited {
+				t.Fatalf("Expected attempt %d to be allowed: %v, but it was: %v", tc.attempts+1, !tc.limited, ok)
+			}
+		})
+	}
+	t.Run("Jitter stays within bounds", func(t *testing.T) {
+		t.Parallel()
+		strategy := WithJitter(FixedInterval(100*time.Millisecond), 0.2)
+		for i := 1; i <= 1000; i++ {
+			interval, ok := strategy.Next(i)
+			if !ok {
+				t.Fatalf("Expected attempt %d to be allowed", i)
+			} else if interval < 80*time.Millisecond || interval > 120*time.Millisecond {
+				t.Fatalf("Expected interval between 80ms and 120ms, got %s", interval)
+			}
+		}
+	})
+	t.Run("Jitter preserves the end of polling", func(t *testing.T) {
+		t.Parallel()
+		strategy := WithJitter(MaxAttempts(FixedInterval(100*time.Millisecond), 1), 0.5)
+		if _, ok := strategy.Next(2); ok {
+			t.Fatalf("Expected attempt 2 to be disallowed")
+		}
+	})
+	t.Run("Invalid arguments panic", func(t *testing.T) {
+		t.Parallel()
+		for name, f := range map[string]func(){
+			"negative interval":    func() { FixedInterval(-1) },
+			"small factor":         func() { ExponentialBackoff(time.Second, 0.5, 0) },
+			"out of range jitter":  func() { WithJitter(FixedInterval(time.Second), 1.5) },
+			"no attempts":          func() { MaxAttempts(FixedInterval(time.Second), 0) },
+			"nil jittered":         func() { WithJitter(nil, 0.1) },
+			"nil attempts-limited": func() { MaxAttempts(nil, 1) },
+		} {
+			f := f
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				With(t).VerifyThat(f).Will(Panic()).Now()
+			})
+		}
+	})
+}
+
+func TestAssertionUsingPollingStrategy(t *testing.T) {
+	t.Parallel()
+	t.Run("WithinUsing waits according to the strategy", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		clock := NewFakeClock(time.Now())
+		var waits []time.Duration
+		last := clock.Now()
+		With(mt).UsingClock(clock).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) {
+			waits = append(waits, clock.Now().Sub(last))
+			last = clock.Now()
+			if len(waits) < 4 {
+				t.Fatalf("attempt %d failed", len(waits))
+			}
+		})).WithinUsing(time.Minute, ExponentialBackoff(time.Second, 2, 0))
+		expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second}
+		With(t).VerifyThat(waits).Will(EqualTo(expected)).Now()
+	})
+	t.Run("WithinUsing fails once attempts are exhausted", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^attempt 3 failed\nGave up after 3 attempts waiting for assertion to pass\n.*`))
+		clock := NewFakeClock(time.Now())
+		attempts := 0
+		With(mt).UsingClock(clock).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) {
+			attempts++
+			t.Fatalf("attempt %d failed", attempts)
+		})).WithinUsing(time.Hour, MaxAttempts(FixedInterval(time.Second), 3))
+	})
+	t.Run("ForUsing passes once attempts are exhausted", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(SuccessVerifier())
+		clock := NewFakeClock(time.Now())
+		attempts := 0
+		With(mt).UsingClock(clock).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) { attempts++ })).
+			ForUsing(time.Hour, MaxAttempts(FixedInterval(time.Second), 5))
+		With(t).VerifyThat(attempts).Will(EqualTo(5)).Now()
+	})
+	t.Run("ForUsing fails on first mismatch", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`^attempt 2 failed\nAssertion failed after 3s and did not pass repeatedly for 1h0m0s\n.*`))
+		clock := NewFakeClock(time.Now())
+		attempts := 0
+		With(mt).UsingClock(clock).VerifyThat().Will(MatcherFunc(func(t T, actuals ...any) {
+			attempts++
+			if attempts == 2 {
+				t.Fatalf("attempt %d failed", attempts)
+			}
+		})).ForUsing(time.Hour, ExponentialBackoff(time.Second, 2, 0))
+	})
+}
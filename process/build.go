@@ -0,0 +1,107 @@
+package process
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	. "github.com/arikkfir/justest"
+)
+
+var (
+	buildMu  sync.Mutex
+	buildDir string
+	builds   = make(map[string]*build)
+)
+
+// build is a single "go build" invocation, shared by all tests building the same package & arguments; different
+// builds run concurrently, while tests requesting the same build wait for it to complete.
+type build struct {
+	once       sync.Once
+	dir        string
+	executable string
+	output     string
+	err        error
+}
+
+// Build builds the given main package (an import path, or a path relative to the test's package directory, such as
+// "./cmd/server") using "go build" with the given additional arguments (e.g. "-race"), and returns the path of the
+// resulting executable. Each package & arguments combination is only built once per test binary; executables are
+// placed in a temporary directory that can be removed by calling CleanupBuildArtifacts in TestMain. The test fails if
+// the build fails.
+//
+//go:noinline
+func Build(t T, pkg string, args ...string) string {
+	GetHelper(t).Helper()
+
+	b, err := getBuild(strings.Join(append([]string{pkg}, args...), " "))
+	if err != nil {
+		t.Fatalf("Failed creating build directory: %+v", err)
+	}
+
+	b.once.Do(func() {
+		executable := filepath.Join(b.dir, path.Base(filepath.ToSlash(pkg)))
+		if runtime.GOOS == "windows" {
+			executable += ".exe"
+		}
+		cmdArgs := append(append([]string{"build", "-o", executable}, args...), pkg)
+		if output, err := exec.Command("go", cmdArgs...).CombinedOutput(); err != nil {
+			b.output, b.err = strings.TrimSpace(string(output)), err
+		} else {
+			b.executable = executable
+		}
+	})
+	if b.err != nil {
+		t.Fatalf("Failed building '%s': %+v\n%s", pkg, b.err, b.output)
+	}
+	return b.executable
+}
+
+// getBuild returns the build of the given key, creating it (and the build directory) if necessary; builds are only
+// registered here, and run outside the lock.
+func getBuild(key string) (*build, error) {
+	buildMu.Lock()
+	defer buildMu.Unlock()
+
+	if b, ok := builds[key]; ok {
+		return b, nil
+	}
+
+	if buildDir == "" {
+		dir, err := os.MkdirTemp("", "justest-build-")
+		if err != nil {
+			return nil, err
+		}
+		buildDir = dir
+	}
+
+	// Each build gets its own directory, since different builds may produce executables with the same name
+	b := &build{dir: filepath.Join(buildDir, fmt.Sprint(len(builds)))}
+	builds[key] = b
+	return b, nil
+}
+
+// CleanupBuildArtifacts removes the executables built by Build. It is meant to be called in TestMain, after the tests
+// have run:
+//
+//	func TestMain(m *testing.M) {
+//		code := m.Run()
+//		process.CleanupBuildArtifacts()
+//		os.Exit(code)
+//	}
+//
+//go:noinline
+func CleanupBuildArtifacts() {
+	buildMu.Lock()
+	defer buildMu.Unlock()
+	if buildDir != "" {
+		_ = os.RemoveAll(buildDir)
+		buildDir = ""
+	}
+	builds = make(map[string]*build)
+}
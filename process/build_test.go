@@ -0,0 +1,47 @@
+package process_test
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+	. "github.com/arikkfir/justest/process"
+)
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+	t.Run("Builds once per package and arguments", func(t *testing.T) {
+		t.Parallel()
+		executable := Build(t, "./testdata/helper")
+		With(t).VerifyThat(os.Stat(executable)).Will(Succeed()).Now()
+		With(t).VerifyThat(Build(t, "./testdata/helper")).Will(EqualTo(executable)).Now()
+		With(t).VerifyThat(Build(t, "./testdata/helper", "-trimpath")).Will(Not(EqualTo(executable))).Now()
+	})
+	t.Run("Concurrent builds of the same package share the executable", func(t *testing.T) {
+		t.Parallel()
+		executables := make(chan string, 5)
+		var wg sync.WaitGroup
+		for i := 0; i < cap(executables); i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				executables <- Build(t, "./testdata/helper", "-buildvcs=false")
+			}()
+		}
+		wg.Wait()
+		close(executables)
+		executable := <-executables
+		With(t).VerifyThat(os.Stat(executable)).Will(Succeed()).Now()
+		for other := range executables {
+			With(t).VerifyThat(other).Will(EqualTo(executable)).Now()
+		}
+	})
+	t.Run("Build failure", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`Failed building './testdata/missing': exit status 1\n.*testdata/missing`))
+		Build(mt, "./testdata/missing")
+	})
+}
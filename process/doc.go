@@ -0,0 +1,14 @@
+// Package process provides utilities for testing external processes, such as command-line tools, with justest.
+//
+// Start runs a command as a Session, whose standard output and error are captured into justest.Buffer instances, so
+// they can be matched in order using the Say matcher. The Exit matcher checks that a session exited (optionally with
+// a specific exit code), and Build builds a main package once per test binary. For example:
+//
+//	func TestServer(t *testing.T) {
+//		session := process.Start(t, exec.Command(process.Build(t, "github.com/example/server"), "--port", "8080"))
+//		With(t).VerifyThat(session.Out).Will(Say("listening on")).Within(5*time.Second, 50*time.Millisecond)
+//		With(t).VerifyThat(session.Kill()).Will(process.Exit()).Within(5*time.Second, 50*time.Millisecond)
+//	}
+//
+// Sessions are killed automatically when the test ends.
+package process
@@ -0,0 +1,14 @@
+package process_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/arikkfir/justest/process"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	process.CleanupBuildArtifacts()
+	os.Exit(code)
+}
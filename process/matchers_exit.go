@@ -0,0 +1,48 @@
+package process
+
+import (
+	"fmt"
+	"reflect"
+
+	. "github.com/arikkfir/justest"
+)
+
+var (
+	exitValueExtractor ValueExtractor
+)
+
+func init() {
+	exitValueExtractor = NewValueExtractor(ExtractSameValue)
+	exitValueExtractor[reflect.Chan] = NewChannelExtractor(exitValueExtractor, true)
+	exitValueExtractor[reflect.Func] = NewFuncExtractor(exitValueExtractor, true)
+}
+
+// Exit returns a matcher that checks that all given sessions exited. If an exit code is given, the sessions must have
+// exited with that code. The matcher does not wait for the sessions to exit, so use it with Within to wait for them:
+//
+//	With(t).VerifyThat(session).Will(Exit(0)).Within(5*time.Second, 50*time.Millisecond)
+//
+//go:noinline
+func Exit(code ...int) Matcher {
+	if len(code) > 1 {
+		panic("expected at most one exit code")
+	}
+
+	m := MatcherFunc(func(t T, actuals ...any) {
+		GetHelper(t).Helper()
+		for _, actual := range actuals {
+			s, ok := exitValueExtractor.MustExtractValue(t, actual).(*Session)
+			if !ok {
+				t.Fatalf("Unsupported actual value for Exit matcher: %+v (%T)", actual, actual)
+			} else if !s.HasExited() {
+				t.Fatalf("Expected %s to exit, but it is still running", s)
+			} else if exitCode := s.ExitCode(); len(code) == 1 && exitCode != code[0] {
+				t.Fatalf("Expected %s to exit with code %d, but it exited with code %d", s, code[0], exitCode)
+			}
+		}
+	})
+	if len(code) == 1 {
+		return Described(m, fmt.Sprintf("to exit with code %d", code[0]), fmt.Sprintf("not to exit with code %d", code[0]))
+	}
+	return Described(m, "to exit", "not to exit")
+}
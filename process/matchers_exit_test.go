@@ -0,0 +1,52 @@
+package process_test
+
+import (
+	"os/exec"
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+	. "github.com/arikkfir/justest/process"
+)
+
+func TestExit(t *testing.T) {
+	t.Parallel()
+	helper := Build(t, "./testdata/helper")
+
+	type testCase struct {
+		args     []string
+		matcher  Matcher
+		verifier TestOutcomeVerifier
+	}
+	testCases := map[string]testCase{
+		"Exited":                        {args: []string{"-exit", "3"}, matcher: Exit(), verifier: SuccessVerifier()},
+		"Exited with expected code":     {args: []string{"-exit", "3"}, matcher: Exit(3), verifier: SuccessVerifier()},
+		"Exited with unexpected code":   {args: []string{"-exit", "3"}, matcher: Exit(0), verifier: FailureVerifier(`Expected process '.+helper -exit 3' \(pid \d+\) to exit with code 0, but it exited with code 3`)},
+		"Still running":                 {args: []string{"-sleep", "1m"}, matcher: Exit(), verifier: FailureVerifier(`Expected process '.+helper -sleep 1m' \(pid \d+\) to exit, but it is still running`)},
+		"Still running with exit code":  {args: []string{"-sleep", "1m"}, matcher: Exit(0), verifier: FailureVerifier(`Expected process '.+helper -sleep 1m' \(pid \d+\) to exit, but it is still running`)},
+		"Negation of a running process": {args: []string{"-sleep", "1m"}, matcher: Not(Exit()), verifier: SuccessVerifier()},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			session := Start(t, exec.Command(helper, tc.args...))
+			if tc.args[0] == "-exit" {
+				<-session.Exited()
+			}
+			mt := NewMockT(t)
+			defer mt.Verify(tc.verifier)
+			With(mt).VerifyThat(session).Will(tc.matcher).Now()
+		})
+	}
+	t.Run("Unsupported actual", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`Unsupported actual value for Exit matcher: abc \(string\)`))
+		With(mt).VerifyThat("abc").Will(Exit()).Now()
+	})
+	t.Run("Too many exit codes", func(t *testing.T) {
+		t.Parallel()
+		With(t).VerifyThat(func() { Exit(1, 2) }).Will(PanicWith(EqualTo("expected at most one exit code"))).Now()
+	})
+}
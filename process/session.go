@@ -0,0 +1,118 @@
+package process
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	. "github.com/arikkfir/justest"
+)
+
+// Session is a running (or exited) process started by Start.
+type Session struct {
+	// Command is the command of the process.
+	Command *exec.Cmd
+
+	// Out captures the standard output of the process.
+	Out *Buffer
+
+	// Err captures the standard error of the process.
+	Err *Buffer
+
+	exited   chan struct{}
+	mu       sync.Mutex
+	exitCode int
+}
+
+// Start starts the given command, capturing its standard output and error into the session's Out and Err buffers (as
+// well as into the command's own Stdout and Stderr writers, if set). The process is killed when the test ends, unless
+// it exited by then. The test fails if the command cannot be started.
+//
+//go:noinline
+func Start(t T, cmd *exec.Cmd) *Session {
+	GetHelper(t).Helper()
+
+	s := &Session{Command: cmd, Out: NewBuffer(), Err: NewBuffer(), exited: make(chan struct{}), exitCode: -1}
+	cmd.Stdout = teeWriter(s.Out, cmd.Stdout)
+	cmd.Stderr = teeWriter(s.Err, cmd.Stderr)
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("Failed starting command '%s': %+v", s.commandLine(), err)
+	}
+
+	go s.wait()
+	t.Cleanup(func() {
+		s.Kill()
+		<-s.exited
+	})
+	return s
+}
+
+// teeWriter returns a writer that writes to both given writers, or just the first one if the second one is nil.
+func teeWriter(w io.Writer, other io.Writer) io.Writer {
+	if other == nil {
+		return w
+	}
+	return io.MultiWriter(w, other)
+}
+
+// wait waits for the process to exit, records its exit code and closes its output buffers.
+func (s *Session) wait() {
+	_ = s.Command.Wait()
+
+	s.mu.Lock()
+	s.exitCode = s.Command.ProcessState.ExitCode()
+	s.mu.Unlock()
+
+	_ = s.Out.Close()
+	_ = s.Err.Close()
+	close(s.exited)
+}
+
+// commandLine returns the command line of the session's command.
+func (s *Session) commandLine() string {
+	return strings.Join(s.Command.Args, " ")
+}
+
+// ExitCode returns the exit code of the process, or -1 if it is still running (or was terminated by a signal).
+func (s *Session) ExitCode() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.exitCode
+}
+
+// Exited returns a channel that is closed once the process exits and its output has been captured.
+func (s *Session) Exited() <-chan struct{} {
+	return s.exited
+}
+
+// HasExited returns true if the process exited and its output has been captured.
+func (s *Session) HasExited() bool {
+	select {
+	case <-s.exited:
+		return true
+	default:
+		return false
+	}
+}
+
+// Signal sends the given signal to the process, unless it already exited. The session itself is returned, so that its
+// exit can be asserted directly, e.g. "With(t).VerifyThat(session.Signal(os.Interrupt)).Will(Exit()).Within(...)".
+func (s *Session) Signal(sig os.Signal) *Session {
+	if !s.HasExited() {
+		_ = s.Command.Process.Signal(sig)
+	}
+	return s
+}
+
+// Kill kills the process, unless it already exited. The session itself is returned, like in Signal.
+func (s *Session) Kill() *Session {
+	return s.Signal(os.Kill)
+}
+
+// String returns a description of the session's command and process.
+func (s *Session) String() string {
+	return fmt.Sprintf("process '%s' (pid %d)", s.commandLine(), s.Command.Process.Pid)
+}
@@ -0,0 +1,59 @@
+package process_test
+
+import (
+	"bytes"
+	"os/exec"
+	"testing"
+	"time"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+	. "github.com/arikkfir/justest/process"
+)
+
+func TestStart(t *testing.T) {
+	t.Parallel()
+	helper := Build(t, "./testdata/helper")
+
+	t.Run("Captures output in order", func(t *testing.T) {
+		t.Parallel()
+		session := Start(t, exec.Command(helper, "-out", "starting,listening on :8080,ready", "-err", "warning", "-delay", "10ms"))
+		With(t).VerifyThat(session.Out).Will(Say("^starting\n")).Within(5*time.Second, 10*time.Millisecond)
+		With(t).VerifyThat(session.Out).Will(Say("^listening on :8080\n")).Within(5*time.Second, 10*time.Millisecond)
+		With(t).VerifyThat(session.Out).Will(Say("^ready\n")).Within(5*time.Second, 10*time.Millisecond)
+		With(t).VerifyThat(session.Err).Will(Say("warning")).Within(5*time.Second, 10*time.Millisecond)
+		With(t).VerifyThat(session).Will(Exit(0)).Within(5*time.Second, 10*time.Millisecond)
+		With(t).VerifyThat(session.Out.Closed()).Will(EqualTo(true)).Now()
+	})
+	t.Run("Copies output to command writers", func(t *testing.T) {
+		t.Parallel()
+		var stdout bytes.Buffer
+		cmd := exec.Command(helper, "-out", "hello")
+		cmd.Stdout = &stdout
+		session := Start(t, cmd)
+		With(t).VerifyThat(session).Will(Exit(0)).Within(5*time.Second, 10*time.Millisecond)
+		With(t).VerifyThat(stdout.String()).Will(EqualTo("hello\n")).Now()
+		With(t).VerifyThat(session.Out.String()).Will(EqualTo("hello\n")).Now()
+	})
+	t.Run("Kill", func(t *testing.T) {
+		t.Parallel()
+		session := Start(t, exec.Command(helper, "-sleep", "1m"))
+		With(t).VerifyThat(session).Will(Not(Exit())).For(50*time.Millisecond, 10*time.Millisecond)
+		With(t).VerifyThat(session.Kill()).Will(Exit()).Within(5*time.Second, 10*time.Millisecond)
+		With(t).VerifyThat(session.ExitCode()).Will(EqualTo(-1)).Now()
+	})
+	t.Run("Killed when the test ends", func(t *testing.T) {
+		t.Parallel()
+		var session *Session
+		t.Run("Starts the process", func(t *testing.T) {
+			session = Start(t, exec.Command(helper, "-sleep", "1m"))
+		})
+		With(t).VerifyThat(session.HasExited()).Will(EqualTo(true)).Now()
+	})
+	t.Run("Start failure", func(t *testing.T) {
+		t.Parallel()
+		mt := NewMockT(t)
+		defer mt.Verify(FailureVerifier(`Failed starting command '/does/not/exist --flag': .*no such file or directory`))
+		Start(mt, exec.Command("/does/not/exist", "--flag"))
+	})
+}
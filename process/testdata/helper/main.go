@@ -0,0 +1,32 @@
+// Command helper is a test program whose behavior is controlled by its flags.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+func main() {
+	out := flag.String("out", "", "text to print to stdout (one line per comma-separated item)")
+	errOut := flag.String("err", "", "text to print to stderr")
+	delay := flag.Duration("delay", 0, "delay before printing each line, and before exiting")
+	sleep := flag.Duration("sleep", 0, "time to sleep before exiting")
+	code := flag.Int("exit", 0, "exit code")
+	flag.Parse()
+
+	if *out != "" {
+		for _, line := range strings.Split(*out, ",") {
+			time.Sleep(*delay)
+			fmt.Println(line)
+		}
+	}
+	if *errOut != "" {
+		fmt.Fprintln(os.Stderr, *errOut)
+	}
+	time.Sleep(*delay)
+	time.Sleep(*sleep)
+	os.Exit(*code)
+}
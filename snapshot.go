@@ -0,0 +1,313 @@
+package justest
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// UpdateSnapshotsEnvVarName is the environment variable that, when set to a true value (e.g. "1"), makes MatchSnapshot
+// rewrite mismatching snapshots instead of failing, and RunWithSnapshots remove obsolete snapshots.
+const UpdateSnapshotsEnvVarName = "JUSTEST_UPDATE_SNAPSHOTS"
+
+const snapshotFileSuffix = ".snap"
+
+var (
+	snapshotHeaderRegex = regexp.MustCompile(`^=== (.+) \((\d+) bytes\)$`)
+	defaultSnapshots    = newSnapshotStore(filepath.Join("testdata", "__snapshots__"), envUpdateSnapshots)
+)
+
+// envUpdateSnapshots returns true if the JUSTEST_UPDATE_SNAPSHOTS environment variable is set to a true value.
+func envUpdateSnapshots() bool {
+	update, _ := strconv.ParseBool(os.Getenv(UpdateSnapshotsEnvVarName))
+	return update
+}
+
+// snapshotResult is the outcome of checking a value against its snapshot.
+type snapshotResult int
+
+const (
+	snapshotMatched snapshotResult = iota
+	snapshotCreated
+	snapshotUpdated
+	snapshotMismatched
+)
+
+// snapshotFile holds the snapshots of a single top-level test, keyed by the test name and a per-test counter.
+type snapshotFile struct {
+	path    string
+	entries map[string]string
+	used    map[string]bool
+}
+
+// write writes the snapshots to the file, as a sequence of entries, each consisting of a header line with the key and
+// the length of the snapshot, followed by the snapshot itself and a newline. The file is removed if it has no entries.
+func (f *snapshotFile) write() error {
+	if len(f.entries) == 0 {
+		if err := os.Remove(f.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+
+	keys := make([]string, 0, len(f.entries))
+	for key := range f.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, key := range keys {
+		value := f.entries[key]
+		buf.WriteString(fmt.Sprintf("=== %s (%d bytes)\n%s\n", key, len(value), value))
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(f.path, buf.Bytes(), 0644)
+}
+
+// readSnapshotFile reads the snapshots stored in the given file, which need not exist.
+func readSnapshotFile(path string) (*snapshotFile, error) {
+	f := &snapshotFile{path: path, entries: make(map[string]string), used: make(map[string]bool)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return f, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(bytes.NewReader(data))
+	for {
+		header, err := r.ReadString('\n')
+		if err == io.EOF && header == "" {
+			return f, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("malformed snapshot file '%s': unexpected end of file", path)
+		}
+
+		groups := snapshotHeaderRegex.FindStringSubmatch(strings.TrimSuffix(header, "\n"))
+		if groups == nil {
+			return nil, fmt.Errorf("malformed snapshot file '%s': invalid entry header: %s", path, strings.TrimSpace(header))
+		}
+		length, err := strconv.Atoi(groups[2])
+		if err != nil {
+			return nil, fmt.Errorf("malformed snapshot file '%s': invalid entry length: %w", path, err)
+		}
+
+		value := make([]byte, length+1)
+		if _, err := io.ReadFull(r, value); err != nil || value[length] != '\n' {
+			return nil, fmt.Errorf("malformed snapshot file '%s': entry '%s' is truncated", path, groups[1])
+		}
+		f.entries[groups[1]] = string(value[:length])
+	}
+}
+
+// snapshotStore manages the snapshot files of a directory, one file per top-level test.
+type snapshotStore struct {
+	dir       string
+	update    func() bool
+	mu        sync.Mutex
+	files     map[string]*snapshotFile
+	counters  map[string]int
+	completed map[string]bool
+}
+
+func newSnapshotStore(dir string, update func() bool) *snapshotStore {
+	return &snapshotStore{
+		dir:       dir,
+		update:    update,
+		files:     make(map[string]*snapshotFile),
+		counters:  make(map[string]int),
+		completed: make(map[string]bool),
+	}
+}
+
+// nextKey returns the key of the next snapshot of the test with the given name; keys consist of the test name and a
+// counter, which restarts when the test is run again (e.g. with "-count"). Tests that run to completion (without being
+// skipped or failing) are remembered, since only their unchecked snapshots are known to be obsolete.
+func (s *snapshotStore) nextKey(tb testing.TB) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := tb.Name()
+	if s.counters[name] == 0 {
+		tb.Cleanup(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			delete(s.counters, name)
+			if !tb.Skipped() && !tb.Failed() {
+				s.completed[name] = true
+			}
+		})
+	}
+	s.counters[name]++
+	return fmt.Sprintf("%s %d", name, s.counters[name])
+}
+
+// file returns the snapshot file of the given top-level test, reading it if necessary; the store must be locked.
+func (s *snapshotStore) file(name string) (*snapshotFile, error) {
+	if f, ok := s.files[name]; ok {
+		return f, nil
+	}
+	f, err := readSnapshotFile(filepath.Join(s.dir, name+snapshotFileSuffix))
+	if err != nil {
+		return nil, err
+	}
+	s.files[name] = f
+	return f, nil
+}
+
+// check compares the given value with the snapshot of the given key, creating it if it does not exist yet, or updating
+// it if it differs and update mode is enabled. The stored snapshot is returned as well.
+func (s *snapshotStore) check(key, value string) (string, snapshotResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Keys start with the test name, whose top-level test name (e.g. "TestA" in "TestA/sub 1") names the file
+	f, err := s.file(key[:strings.IndexAny(key, "/ ")])
+	if err != nil {
+		return "", 0, err
+	}
+	f.used[key] = true
+
+	expected, found := f.entries[key]
+	switch {
+	case found && expected == value:
+		return expected, snapshotMatched, nil
+	case found && !s.update():
+		return expected, snapshotMismatched, nil
+	}
+
+	f.entries[key] = value
+	if err := f.write(); err != nil {
+		return "", 0, fmt.Errorf("failed writing snapshot file: %w", err)
+	} else if found {
+		return expected, snapshotUpdated, nil
+	}
+	return "", snapshotCreated, nil
+}
+
+// isObsolete returns true if the snapshot of the given key in the given file was not checked, even though the test
+// owning it ran to completion; snapshots of tests that did not run, or were skipped (e.g. by "-short", or before
+// checking any snapshot), are never obsolete, since they may still be checked by other runs. The store must be locked.
+func (s *snapshotStore) isObsolete(f *snapshotFile, key string) bool {
+	return !f.used[key] && s.completed[key[:strings.LastIndex(key, " ")]]
+}
+
+// obsolete returns the keys of the snapshots that were not checked by the tests owning them, grouped by the path of
+// their file.
+func (s *snapshotStore) obsolete() (map[string][]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obsolete := make(map[string][]string)
+	for _, f := range s.files {
+		for key := range f.entries {
+			if s.isObsolete(f, key) {
+				obsolete[f.path] = append(obsolete[f.path], key)
+			}
+		}
+		sort.Strings(obsolete[f.path])
+	}
+	return obsolete, nil
+}
+
+// removeObsolete removes the snapshots that were not checked.
+func (s *snapshotStore) removeObsolete() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range s.files {
+		changed := false
+		for key := range f.entries {
+			if s.isObsolete(f, key) {
+				delete(f.entries, key)
+				changed = true
+			}
+		}
+		if changed {
+			if err := f.write(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isPartialTestRun returns true if only some of the tests were run (e.g. using "-run"), in which case snapshots that
+// were not checked are not necessarily obsolete.
+func isPartialTestRun() bool {
+	for _, name := range []string{"test.run", "test.skip", "test.list"} {
+		if f := flag.Lookup(name); f != nil && f.Value.String() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// RunWithSnapshots runs the tests of the given testing.M, and if all tests pass (and no test filter such as "-run" was
+// given), fails the run if any snapshot was not checked by its test, listing these obsolete snapshots; if
+// JUSTEST_UPDATE_SNAPSHOTS is set, they are removed instead. Only snapshots of tests that ran to completion are
+// considered, so snapshots of skipped tests (or tests excluded from the build) are kept. It returns the exit code for
+// os.Exit, and is meant to be used in TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(justest.RunWithSnapshots(m))
+//	}
+//
+//go:noinline
+func RunWithSnapshots(m *testing.M) int {
+	code := m.Run()
+	if code != 0 || isPartialTestRun() {
+		return code
+	}
+	return checkObsoleteSnapshots(defaultSnapshots, os.Stderr)
+}
+
+// checkObsoleteSnapshots reports (or in update mode, removes) obsolete snapshots of the given store to the given
+// writer, and returns the exit code: 1 if obsolete snapshots were found (and not removed), or could not be checked.
+func checkObsoleteSnapshots(s *snapshotStore, w io.Writer) int {
+	obsolete, err := s.obsolete()
+	if err != nil {
+		_, _ = fmt.Fprintf(w, "Failed checking for obsolete snapshots: %v\n", err)
+		return 1
+	} else if len(obsolete) == 0 {
+		return 0
+	}
+
+	paths := make([]string, 0, len(obsolete))
+	count := 0
+	for path, keys := range obsolete {
+		paths = append(paths, path)
+		count += len(keys)
+	}
+	sort.Strings(paths)
+
+	if s.update() {
+		if err := s.removeObsolete(); err != nil {
+			_, _ = fmt.Fprintf(w, "Failed removing obsolete snapshots: %v\n", err)
+			return 1
+		}
+		_, _ = fmt.Fprintf(w, "Removed %d obsolete snapshot(s)\n", count)
+		return 0
+	}
+
+	_, _ = fmt.Fprintf(w, "Found %d obsolete snapshot(s) (set %s=1 to remove them):\n", count, UpdateSnapshotsEnvVarName)
+	for _, path := range paths {
+		for _, key := range obsolete[path] {
+			_, _ = fmt.Fprintf(w, "  %s: %s\n", path, key)
+		}
+	}
+	return 1
+}
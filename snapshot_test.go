@@ -0,0 +1,185 @@
+package justest
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSnapshotFileRoundTrip(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "sub", "TestSomething.snap")
+	f := &snapshotFile{path: path, entries: map[string]string{
+		"TestSomething 1":     "plain",
+		"TestSomething/sub 1": "=== TestSomething 2 (3 bytes)\nabc\n",
+		"TestSomething/sub 2": "",
+		"TestSomething 2":     "no trailing newline\n\n",
+	}}
+	With(t).VerifyThat(f.write()).Will(Succeed()).Now()
+
+	data, err := os.ReadFile(path)
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(string(data)).Will(EqualTo("" +
+		"=== TestSomething 1 (5 bytes)\nplain\n" +
+		"=== TestSomething 2 (21 bytes)\nno trailing newline\n\n\n" +
+		"=== TestSomething/sub 1 (34 bytes)\n=== TestSomething 2 (3 bytes)\nabc\n\n" +
+		"=== TestSomething/sub 2 (0 bytes)\n\n",
+	)).Now()
+
+	read, err := readSnapshotFile(path)
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(read.entries).Will(EqualTo(f.entries)).Now()
+
+	f.entries = map[string]string{}
+	With(t).VerifyThat(f.write()).Will(Succeed()).Now()
+	_, err = os.Stat(path)
+	With(t).VerifyThat(err).Will(MatchError(os.ErrNotExist)).Now()
+}
+
+func TestReadSnapshotFile(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		content string
+		error   string
+	}
+	testCases := map[string]testCase{
+		"Empty file":       {content: "", error: ""},
+		"Invalid header":   {content: "abc\n", error: `invalid entry header: abc$`},
+		"Truncated entry":  {content: "=== TestA 1 (10 bytes)\nabc\n", error: `entry 'TestA 1' is truncated$`},
+		"Missing newline":  {content: "=== TestA 1 (3 bytes)\nabcd", error: `entry 'TestA 1' is truncated$`},
+		"Unterminated end": {content: "=== TestA 1 (3 bytes)\nabc\n=== TestA 2", error: `unexpected end of file$`},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), "TestA.snap")
+			With(t).VerifyThat(os.WriteFile(path, []byte(tc.content), 0644)).Will(Succeed()).Now()
+			_, err := readSnapshotFile(path)
+			if tc.error == "" {
+				With(t).VerifyThat(err).Will(Succeed()).Now()
+			} else {
+				With(t).VerifyThat(err).Will(MatchError(`^malformed snapshot file '.+': ` + tc.error)).Now()
+			}
+		})
+	}
+}
+
+func TestSnapshotStore(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	update := false
+	store := newSnapshotStore(dir, func() bool { return update })
+
+	With(t).VerifyThat(store.nextKey(t)).Will(EqualTo("TestSnapshotStore 1")).Now()
+	With(t).VerifyThat(store.nextKey(t)).Will(EqualTo("TestSnapshotStore 2")).Now()
+	t.Run("sub", func(t *testing.T) {
+		With(t).VerifyThat(store.nextKey(t)).Will(EqualTo("TestSnapshotStore/sub 1")).Now()
+	})
+	t.Run("sub", func(t *testing.T) {
+		With(t).VerifyThat(store.nextKey(t)).Will(EqualTo("TestSnapshotStore/sub#01 1")).Now()
+	})
+
+	// Snapshots are created on first check
+	expected, result, err := store.check("TestA 1", "abc")
+	With(t).VerifyThat(expected, result, err).Will(EqualTo("", snapshotCreated, nil)).Now()
+	expected, result, err = store.check("TestA/sub 1", "def")
+	With(t).VerifyThat(expected, result, err).Will(EqualTo("", snapshotCreated, nil)).Now()
+
+	// Snapshots are read back from their files
+	store = newSnapshotStore(dir, func() bool { return update })
+	expected, result, err = store.check("TestA 1", "abc")
+	With(t).VerifyThat(expected, result, err).Will(EqualTo("abc", snapshotMatched, nil)).Now()
+	expected, result, err = store.check("TestA/sub 1", "xyz")
+	With(t).VerifyThat(expected, result, err).Will(EqualTo("def", snapshotMismatched, nil)).Now()
+
+	// Snapshots are rewritten in update mode
+	update = true
+	expected, result, err = store.check("TestA/sub 1", "xyz")
+	With(t).VerifyThat(expected, result, err).Will(EqualTo("def", snapshotUpdated, nil)).Now()
+	data, err := os.ReadFile(filepath.Join(dir, "TestA.snap"))
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(string(data)).Will(EqualTo("=== TestA 1 (3 bytes)\nabc\n=== TestA/sub 1 (3 bytes)\nxyz\n")).Now()
+}
+
+func TestObsoleteSnapshots(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "TestObsoleteSnapshots.snap")
+	snapshots := "" +
+		"=== TestObsoleteSnapshots/ran 1 (1 bytes)\na\n" +
+		"=== TestObsoleteSnapshots/ran 2 (1 bytes)\nb\n" +
+		"=== TestObsoleteSnapshots/skipped 1 (1 bytes)\nc\n"
+	With(t).VerifyThat(os.WriteFile(path, []byte(snapshots), 0644)).Will(Succeed()).Now()
+	With(t).VerifyThat(os.WriteFile(filepath.Join(dir, "TestB.snap"), []byte("=== TestB 1 (1 bytes)\nd\n"), 0644)).Will(Succeed()).Now()
+
+	update := false
+	store := newSnapshotStore(dir, func() bool { return update })
+	t.Run("ran", func(t *testing.T) {
+		_, result, err := store.check(store.nextKey(t), "a")
+		With(t).VerifyThat(result, err).Will(EqualTo(snapshotMatched, nil)).Now()
+	})
+	t.Run("skipped", func(t *testing.T) {
+		t.Skip("skipped before checking any snapshot")
+	})
+
+	// Snapshots of tests that did not run to completion (e.g. "TestB", which did not run at all) are not obsolete
+	obsolete, err := store.obsolete()
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(obsolete).Will(EqualTo(map[string][]string{path: {"TestObsoleteSnapshots/ran 2"}})).Now()
+
+	var out bytes.Buffer
+	With(t).VerifyThat(checkObsoleteSnapshots(store, &out)).Will(EqualTo(1)).Now()
+	With(t).VerifyThat(out.String()).Will(EqualTo("" +
+		"Found 1 obsolete snapshot(s) (set JUSTEST_UPDATE_SNAPSHOTS=1 to remove them):\n" +
+		"  " + path + ": TestObsoleteSnapshots/ran 2\n",
+	)).Now()
+
+	update = true
+	out.Reset()
+	With(t).VerifyThat(checkObsoleteSnapshots(store, &out)).Will(EqualTo(0)).Now()
+	With(t).VerifyThat(out.String()).Will(EqualTo("Removed 1 obsolete snapshot(s)\n")).Now()
+	data, err := os.ReadFile(path)
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(string(data)).Will(EqualTo("" +
+		"=== TestObsoleteSnapshots/ran 1 (1 bytes)\na\n" +
+		"=== TestObsoleteSnapshots/skipped 1 (1 bytes)\nc\n",
+	)).Now()
+	_, err = os.Stat(filepath.Join(dir, "TestB.snap"))
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+
+	out.Reset()
+	With(t).VerifyThat(checkObsoleteSnapshots(store, &out)).Will(EqualTo(0)).Now()
+	With(t).VerifyThat(out.String()).Will(BeEmpty()).Now()
+}
+
+func TestSkippedTestSnapshotsAreNotObsolete(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	snapshots := "" +
+		"=== TestSkippedTestSnapshotsAreNotObsolete/skipped 1 (1 bytes)\na\n" +
+		"=== TestSkippedTestSnapshotsAreNotObsolete/skipped 2 (1 bytes)\nb\n" +
+		"=== TestSkippedTestSnapshotsAreNotObsolete/skipped/sub 1 (1 bytes)\nc\n" +
+		"=== TestSkippedTestSnapshotsAreNotObsolete/completed 1 (1 bytes)\nd\n" +
+		"=== TestSkippedTestSnapshotsAreNotObsolete/completed 2 (1 bytes)\ne\n"
+	path := filepath.Join(dir, "TestSkippedTestSnapshotsAreNotObsolete.snap")
+	With(t).VerifyThat(os.WriteFile(path, []byte(snapshots), 0644)).Will(Succeed()).Now()
+
+	store := newSnapshotStore(dir, func() bool { return false })
+	t.Run("skipped", func(t *testing.T) {
+		_, result, err := store.check(store.nextKey(t), "a")
+		With(t).VerifyThat(result, err).Will(EqualTo(snapshotMatched, nil)).Now()
+		t.Skip("skipped after checking a snapshot")
+	})
+	t.Run("completed", func(t *testing.T) {
+		_, result, err := store.check(store.nextKey(t), "d")
+		With(t).VerifyThat(result, err).Will(EqualTo(snapshotMatched, nil)).Now()
+	})
+
+	obsolete, err := store.obsolete()
+	With(t).VerifyThat(err).Will(Succeed()).Now()
+	With(t).VerifyThat(obsolete).Will(EqualTo(map[string][]string{
+		path: {"TestSkippedTestSnapshotsAreNotObsolete/completed 2"},
+	})).Now()
+}
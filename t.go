@@ -1,8 +1,10 @@
 package justest
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 )
 
 type T interface {
@@ -36,15 +38,55 @@ func GetHelper(t T) interface{ Helper() } {
 	return &noOpHelper{}
 }
 
+// GetRoot returns the testing.TB at the root of the given T's ancestry, e.g. the *testing.T of a test, the *testing.B
+// of a benchmark, or the *testing.F of a fuzz target.
+//
 //go:noinline
-func GetRoot(t T) *testing.T {
+func GetRoot(t T) testing.TB {
 	for {
 		if hp, ok := t.(HasParent); ok {
 			t = hp.GetParent()
-		} else if rt, ok := t.(*testing.T); ok {
-			return rt
+		} else if tb, ok := t.(testing.TB); ok {
+			return tb
 		} else {
 			panic(fmt.Sprintf("unsupported T instance: %+v (%T)", t, t))
 		}
 	}
 }
+
+// GetContext returns the context of the given T, or of its nearest ancestor that provides one. Inside timed assertions
+// (For, Within, etc.) this is the context of the current attempt, which is canceled when the attempt is abandoned or
+// finished. If no T in the ancestry provides a context, context.Background is returned.
+//
+//go:noinline
+func GetContext(t T) context.Context {
+	var candidate any = t
+	for candidate != nil {
+		if c, ok := candidate.(interface{ Context() context.Context }); ok {
+			return c.Context()
+		} else if hp, ok := candidate.(HasParent); ok {
+			candidate = hp.GetParent()
+		} else {
+			break
+		}
+	}
+	return context.Background()
+}
+
+// getDeadline returns the deadline of the given T, or of its nearest ancestor that has one (e.g. a testing.T when the
+// test binary is run with a timeout).
+//
+//go:noinline
+func getDeadline(t T) (time.Time, bool) {
+	var candidate any = t
+	for candidate != nil {
+		if d, ok := candidate.(interface{ Deadline() (time.Time, bool) }); ok {
+			return d.Deadline()
+		} else if hp, ok := candidate.(HasParent); ok {
+			candidate = hp.GetParent()
+		} else {
+			break
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,100 @@
+package justest_test
+
+import (
+	"testing"
+
+	. "github.com/arikkfir/justest"
+	. "github.com/arikkfir/justest/justesttest"
+)
+
+func TestGetRoot(t *testing.T) {
+	t.Parallel()
+	t.Run("Test", func(t *testing.T) {
+		t.Parallel()
+		if root := GetRoot(NewMockT(NewMockT(t))); root != t {
+			t.Fatalf("Expected root to be %v, got %v", t, root)
+		}
+	})
+	t.Run("Benchmark", func(t *testing.T) {
+		t.Parallel()
+		var root testing.TB
+		testing.Benchmark(func(b *testing.B) {
+			root = GetRoot(NewMockT(b))
+			if root != b {
+				t.Errorf("Expected root to be %v, got %v", b, root)
+			}
+		})
+		if root == nil {
+			t.Fatalf("Benchmark was not executed")
+		}
+	})
+	t.Run("Unsupported T", func(t *testing.T) {
+		t.Parallel()
+		With(t).VerifyThat(func() { GetRoot(&unsupportedT{}) }).Will(PanicWith(Say(`^unsupported T instance: .*`))).Now()
+	})
+}
+
+func TestAssertionsInBenchmark(t *testing.T) {
+	t.Parallel()
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		result := testing.Benchmark(func(b *testing.B) {
+			With(b).VerifyThat(1).Will(EqualTo(1)).Now()
+			for i := 0; i < b.N; i++ {
+			}
+			With(b).VerifyThat(b.N).Will(BeGreaterThan(0)).Now()
+		})
+		if result.N == 0 {
+			t.Fatalf("Benchmark failed")
+		}
+	})
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		testing.Benchmark(func(b *testing.B) {
+			mt := NewMockT(b)
+			defer mt.Verify(FailureVerifier(`^Unexpected difference.*`))
+			With(mt).VerifyThat(1).Will(EqualTo(2)).Now()
+		})
+	})
+	t.Run("Unevaluated assertion", func(t *testing.T) {
+		t.Parallel()
+		var mt *MockT
+		testing.Benchmark(func(b *testing.B) {
+			mt = NewMockT(b)
+			With(mt).VerifyThat(1).Will(EqualTo(1))
+		})
+		for i := len(mt.Cleanups) - 1; i >= 0; i-- {
+			func() {
+				defer func() { recover() }()
+				mt.Cleanups[i]()
+			}()
+		}
+		With(t).VerifyThat(len(mt.Failures)).Will(EqualTo(1)).Now()
+		With(t).VerifyThat(mt.Failures[0].String()).Will(Say(`^An assertion was not evaluated!`)).Now()
+	})
+}
+
+func BenchmarkWith(b *testing.B) {
+	With(b).VerifyThat(b.N).Will(BeGreaterThan(0)).Now()
+	for i := 0; i < b.N; i++ {
+		With(b).VerifyThat(i).Will(BeLessThan(b.N)).Now()
+	}
+}
+
+func FuzzWith(f *testing.F) {
+	With(f).VerifyThat(f.Name()).Will(Say(`^FuzzWith$`)).Now()
+	f.Add(1, 2)
+	f.Add(-5, 5)
+	f.Fuzz(func(t *testing.T, a, b int) {
+		With(t).VerifyThat(a + b).Will(EqualTo(b + a)).Now()
+	})
+}
+
+type unsupportedT struct{}
+
+func (t *unsupportedT) Name() string                      { return "unsupported" }
+func (t *unsupportedT) Cleanup(func())                    {}
+func (t *unsupportedT) Fatalf(format string, args ...any) {}
+func (t *unsupportedT) Failed() bool                      { return false }
+func (t *unsupportedT) Log(...any)                        {}
+func (t *unsupportedT) Logf(string, ...any)               {}
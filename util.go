@@ -1,24 +1,47 @@
 package justest
 
 import (
+	"fmt"
+	"math"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 )
 
-func transformDurationIfNecessary(t T, d time.Duration) time.Duration {
+// envSlowFactor returns the slow factor configured by the JUSTEST_SLOW_FACTOR environment variable, or 1 if it is not
+// set or invalid.
+func envSlowFactor(t T) float64 {
 	if v, found := os.LookupEnv(SlowFactorEnvVarName); found {
-		if factor, err := strconv.ParseInt(v, 0, 0); err != nil {
+		if factor, err := strconv.ParseFloat(v, 64); err != nil {
 			t.Logf("Ignoring value of '%s' environment variable: %+v", SlowFactorEnvVarName, err)
-			return d
+		} else if factor <= 0 || math.IsInf(factor, 0) || math.IsNaN(factor) {
+			t.Logf("Ignoring value of '%s' environment variable: expected a positive number, got: %s", SlowFactorEnvVarName, v)
 		} else {
-			oldSeconds := int64(d.Seconds())
-			newSeconds := oldSeconds * factor
-			return time.Duration(newSeconds) * time.Second
+			return factor
 		}
 	}
-	return d
+	return 1
+}
+
+// scaleDuration multiplies the given duration by the given factor, preserving sub-second precision.
+func scaleDuration(d time.Duration, factor float64) time.Duration {
+	scaled := float64(d) * factor
+	if scaled > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(scaled)
+}
+
+// describeSlowdown describes how the given duration was scaled by the given slow factor, if at all.
+func describeSlowdown(d time.Duration, factor float64) string {
+	if factor == 1 {
+		return ""
+	} else if d > 0 {
+		return fmt.Sprintf("\nDuration and intervals were slowed by a factor of %v (%s became %s)", factor, d, scaleDuration(d, factor))
+	} else {
+		return fmt.Sprintf("\nIntervals were slowed by a factor of %v", factor)
+	}
 }
 
 func indentIfMultiLine(s string) string {
@@ -31,3 +54,13 @@ func indentIfMultiLine(s string) string {
 	}
 	return s
 }
+
+// formatFailures renders the given failure messages as a numbered list, one failure per line, with continuation lines of
+// multi-line failures indented under their number.
+func formatFailures(failures []string) string {
+	sb := strings.Builder{}
+	for i, failure := range failures {
+		sb.WriteString(fmt.Sprintf("\n[%d] %s", i+1, strings.ReplaceAll(failure, "\n", "\n    ")))
+	}
+	return sb.String()
+}
@@ -5,10 +5,22 @@ import (
 	"time"
 )
 
-func TestTransformDurationIfNecessary(t *testing.T) {
-	With(t).VerifyThat(transformDurationIfNecessary(t, 5*time.Second)).Will(EqualTo(5 * time.Second)).Now()
+func TestEnvSlowFactor(t *testing.T) {
+	With(t).VerifyThat(envSlowFactor(t)).Will(EqualTo(1.0)).Now()
 	t.Setenv(SlowFactorEnvVarName, "2")
-	With(t).VerifyThat(transformDurationIfNecessary(t, 5*time.Second)).Will(EqualTo(10 * time.Second)).Now()
-	t.Setenv(SlowFactorEnvVarName, "3")
-	With(t).VerifyThat(transformDurationIfNecessary(t, 5*time.Second)).Will(EqualTo(15 * time.Second)).Now()
+	With(t).VerifyThat(envSlowFactor(t)).Will(EqualTo(2.0)).Now()
+	t.Setenv(SlowFactorEnvVarName, "1.5")
+	With(t).VerifyThat(envSlowFactor(t)).Will(EqualTo(1.5)).Now()
+	t.Setenv(SlowFactorEnvVarName, "abc")
+	With(t).VerifyThat(envSlowFactor(t)).Will(EqualTo(1.0)).Now()
+	t.Setenv(SlowFactorEnvVarName, "-2")
+	With(t).VerifyThat(envSlowFactor(t)).Will(EqualTo(1.0)).Now()
+}
+
+func TestScaleDuration(t *testing.T) {
+	With(t).VerifyThat(scaleDuration(5*time.Second, 1)).Will(EqualTo(5 * time.Second)).Now()
+	With(t).VerifyThat(scaleDuration(5*time.Second, 3)).Will(EqualTo(15 * time.Second)).Now()
+	With(t).VerifyThat(scaleDuration(500*time.Millisecond, 2)).Will(EqualTo(time.Second)).Now()
+	With(t).VerifyThat(scaleDuration(500*time.Millisecond, 1.5)).Will(EqualTo(750 * time.Millisecond)).Now()
+	With(t).VerifyThat(scaleDuration(time.Second, 0.5)).Will(EqualTo(500 * time.Millisecond)).Now()
 }
@@ -1,6 +1,7 @@
 package justest
 
 import (
+	"context"
 	"reflect"
 
 	. "github.com/arikkfir/justest/internal"
@@ -9,6 +10,7 @@ import (
 var (
 	tTypePkgPath string
 	tTypeName    string
+	contextType  = reflect.TypeOf((*context.Context)(nil)).Elem()
 )
 
 //go:noinline
@@ -130,12 +132,22 @@ func NewFuncExtractor(ve ValueExtractor, recurse bool) Extractor {
 			arg0Type := funcType.In(0)
 			if arg0Type.PkgPath() == tTypePkgPath && arg0Type.Name() == tTypeName {
 				in = append(in, reflect.ValueOf(t))
+			} else if arg0Type == contextType {
+				in = append(in, reflect.ValueOf(GetContext(t)))
 			} else {
-				t.Fatalf("Argument of functions with one argument must be of type TT, found: %+v", arg0Type.Name())
+				t.Fatalf("Argument of functions with one argument must be of type T or context.Context, found: %+v", arg0Type.Name())
+				panic("unreachable")
+			}
+		case 2:
+			arg0Type, arg1Type := funcType.In(0), funcType.In(1)
+			if arg0Type == contextType && arg1Type.PkgPath() == tTypePkgPath && arg1Type.Name() == tTypeName {
+				in = append(in, reflect.ValueOf(GetContext(t)), reflect.ValueOf(t))
+			} else {
+				t.Fatalf("Arguments of functions with two arguments must be of types context.Context and T, found: %+v, %+v", arg0Type.Name(), arg1Type.Name())
 				panic("unreachable")
 			}
 		default:
-			t.Fatalf("Functions with more than 1 input parameter are not supported in this context: %+v", v)
+			t.Fatalf("Functions with more than 2 input parameters are not supported in this context: %+v", v)
 			panic("unreachable")
 		}
 
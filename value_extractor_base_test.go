@@ -1,6 +1,7 @@
 package justest_test
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	. "github.com/arikkfir/justest"
 	. "github.com/arikkfir/justest/internal"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 var (
@@ -376,6 +378,41 @@ func TestNewFuncExtractor(t *testing.T) {
 			wantCalled:      false,
 			expectedOutcome: FailureVerifier(`^Functions with 2 return values must return 'error' as the 2nd return value: .+$`),
 		},
+		"func(context.Context) string receives context": {
+			defaultExtractor: ExtractorUnsupported,
+			extractorsMap:    map[reflect.Kind]Extractor{reflect.String: StringExtractorAddingFooPrefix},
+			actualProvider: func(tc *testCase) any {
+				return func(ctx context.Context) string {
+					tc.called = ctx != nil
+					return "bar"
+				}
+			},
+			wantCalled:               true,
+			expectedOutcome:          SuccessVerifier(),
+			expectedExtractorResults: []any{"bar", true},
+		},
+		"func(context.Context, T) string receives context and T": {
+			defaultExtractor: ExtractorUnsupported,
+			extractorsMap:    map[reflect.Kind]Extractor{reflect.String: StringExtractorAddingFooPrefix},
+			actualProvider: func(tc *testCase) any {
+				return func(ctx context.Context, t T) string {
+					tc.called = ctx != nil && t != nil
+					return "bar"
+				}
+			},
+			wantCalled:               true,
+			expectedOutcome:          SuccessVerifier(),
+			expectedExtractorResults: []any{"bar", true},
+		},
+		"func(T, context.Context) fails because of arguments order": {
+			defaultExtractor: ExtractorUnsupported,
+			extractorsMap:    map[reflect.Kind]Extractor{reflect.String: StringExtractorAddingFooPrefix},
+			actualProvider: func(tc *testCase) any {
+				return func(t T, ctx context.Context) { tc.called = true }
+			},
+			wantCalled:      false,
+			expectedOutcome: FailureVerifier(`^Arguments of functions with two arguments must be of types context.Context and T, found: T, Context$`),
+		},
 	}
 	for name, tc := range testCases {
 		tc := tc
@@ -0,0 +1,133 @@
+package justest
+
+import (
+	"reflect"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	collectionValueExtractor ValueExtractor
+)
+
+func init() {
+	collectionValueExtractor = NewValueExtractor(ExtractorUnsupported)
+	collectionValueExtractor[reflect.Array] = ExtractSameValue
+	collectionValueExtractor[reflect.Chan] = ExtractSameValue
+	collectionValueExtractor[reflect.Func] = NewFuncExtractor(collectionValueExtractor, true)
+	collectionValueExtractor[reflect.Map] = ExtractSameValue
+	collectionValueExtractor[reflect.Pointer] = NewPointerExtractor(collectionValueExtractor, true)
+	collectionValueExtractor[reflect.Slice] = ExtractSameValue
+	collectionValueExtractor[reflect.String] = ExtractSameValue
+}
+
+// elementsOf returns the elements of the given collection. Elements of strings are their runes, elements of maps are
+// their values, and elements of channels are the values currently buffered in them, which are received and thus
+// removed from the channel (so successive calls, e.g. by attempts of timed assertions, see different elements).
+//
+//go:noinline
+func elementsOf(t T, v any) []any {
+	GetHelper(t).Helper()
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array, reflect.Slice:
+		elements := make([]any, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			elements[i] = rv.Index(i).Interface()
+		}
+		return elements
+	case reflect.Chan:
+		var elements []any
+		for {
+			if msg, ok := rv.TryRecv(); ok {
+				elements = append(elements, msg.Interface())
+			} else {
+				return elements
+			}
+		}
+	case reflect.Map:
+		elements := make([]any, 0, rv.Len())
+		for iter := rv.MapRange(); iter.Next(); {
+			elements = append(elements, iter.Value().Interface())
+		}
+		return elements
+	case reflect.String:
+		var elements []any
+		for _, r := range rv.String() {
+			elements = append(elements, r)
+		}
+		return elements
+	default:
+		t.Fatalf("Unsupported collection of type '%T': %+v", v, v)
+		panic("unreachable")
+	}
+}
+
+// elementMatches checks whether the given element satisfies the given expectation. If the expectation is a Matcher, it
+// is applied to the element; otherwise, the element is compared to the expectation using go-cmp.
+//
+//go:noinline
+func elementMatches(t T, expected, element any) bool {
+	GetHelper(t).Helper()
+	if m, ok := expected.(Matcher); ok {
+		return tryAssert(t, m, element) == nil
+	}
+	return cmp.Equal(expected, element)
+}
+
+// describeElement describes the given element expectation, which is either a Matcher or a value.
+func describeElement(expected any) string {
+	if m, ok := expected.(Matcher); ok {
+		return "an element expected " + describe(m)
+	}
+	return "element " + describeValue(expected)
+}
+
+// matchElements pairs each expectation with a distinct element that satisfies it, and returns the expectations and
+// elements that could not be paired.
+//
+//go:noinline
+func matchElements(t T, expectations, elements []any) (unmatchedExpectations, unmatchedElements []any) {
+	GetHelper(t).Helper()
+
+	// Compute which element satisfies which expectation
+	candidates := make([][]int, len(expectations))
+	for i, expected := range expectations {
+		for j, element := range elements {
+			if elementMatches(t, expected, element) {
+				candidates[i] = append(candidates[i], j)
+			}
+		}
+	}
+
+	// Find a maximum bipartite matching between expectations & elements (using augmenting paths)
+	elementOwners := make([]int, len(elements))
+	for j := range elementOwners {
+		elementOwners[j] = -1
+	}
+	var assign func(i int, visited []bool) bool
+	assign = func(i int, visited []bool) bool {
+		for _, j := range candidates[i] {
+			if !visited[j] {
+				visited[j] = true
+				if elementOwners[j] < 0 || assign(elementOwners[j], visited) {
+					elementOwners[j] = i
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for i, expected := range expectations {
+		if !assign(i, make([]bool, len(elements))) {
+			unmatchedExpectations = append(unmatchedExpectations, expected)
+		}
+	}
+	for j, owner := range elementOwners {
+		if owner < 0 {
+			unmatchedElements = append(unmatchedElements, elements[j])
+		}
+	}
+	return
+}
@@ -2,6 +2,8 @@ package justest
 
 import (
 	"cmp"
+	"math"
+	"math/big"
 	"reflect"
 )
 
@@ -27,42 +29,95 @@ func NewNumericValueExtractor() ValueExtractor {
 	sve[reflect.Uint16] = ExtractSameValue
 	sve[reflect.Uint32] = ExtractSameValue
 	sve[reflect.Uint64] = ExtractSameValue
+	sve[reflect.Uintptr] = ExtractSameValue
 	return sve
 }
 
-// getNumericCompareFuncFor returns a reflection wrapper for the [cmp.Compare] function with the correct generic type
-// for the numeric type provided.
+// isNumeric checks whether the given value is of a numeric kind (including named numeric types such as
+// time.Duration).
+func isNumeric(v any) bool {
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// compareNumbers compares the two given numeric values, returning -1 if a is less than b, 0 if they are equal, and +1
+// if a is greater than b. The values can be of any mix of signed, unsigned and floating-point kinds (including named
+// numeric types); they are promoted to a common representation without loss of precision or overflow. Comparing a NaN
+// value fails the given T.
 //
 //go:noinline
-func getNumericCompareFuncFor(t T, v any) reflect.Value {
+func compareNumbers(t T, a, b any) int {
 	GetHelper(t).Helper()
-	switch v.(type) {
-	case int:
-		return reflect.ValueOf(cmp.Compare[int])
-	case int8:
-		return reflect.ValueOf(cmp.Compare[int8])
-	case int16:
-		return reflect.ValueOf(cmp.Compare[int16])
-	case int32:
-		return reflect.ValueOf(cmp.Compare[int32])
-	case int64:
-		return reflect.ValueOf(cmp.Compare[int64])
-	case uint:
-		return reflect.ValueOf(cmp.Compare[uint])
-	case uint8:
-		return reflect.ValueOf(cmp.Compare[uint8])
-	case uint16:
-		return reflect.ValueOf(cmp.Compare[uint16])
-	case uint32:
-		return reflect.ValueOf(cmp.Compare[uint32])
-	case uint64:
-		return reflect.ValueOf(cmp.Compare[uint64])
-	case float32:
-		return reflect.ValueOf(cmp.Compare[float32])
-	case float64:
-		return reflect.ValueOf(cmp.Compare[float64])
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch {
+	case isSignedKind(av.Kind()) && isSignedKind(bv.Kind()):
+		return cmp.Compare(av.Int(), bv.Int())
+	case isUnsignedKind(av.Kind()) && isUnsignedKind(bv.Kind()):
+		return cmp.Compare(av.Uint(), bv.Uint())
+	case isSignedKind(av.Kind()) && isUnsignedKind(bv.Kind()):
+		if av.Int() < 0 {
+			return -1
+		}
+		return cmp.Compare(uint64(av.Int()), bv.Uint())
+	case isUnsignedKind(av.Kind()) && isSignedKind(bv.Kind()):
+		if bv.Int() < 0 {
+			return 1
+		}
+		return cmp.Compare(av.Uint(), uint64(bv.Int()))
 	default:
-		t.Fatalf("Type '%T' of actual '%+v' does not have a defined comparison function", v, v)
+		return toBigFloat(t, a).Cmp(toBigFloat(t, b))
+	}
+}
+
+func isSignedKind(k reflect.Kind) bool {
+	return k == reflect.Int || k == reflect.Int8 || k == reflect.Int16 || k == reflect.Int32 || k == reflect.Int64
+}
+
+func isUnsignedKind(k reflect.Kind) bool {
+	return k == reflect.Uint || k == reflect.Uint8 || k == reflect.Uint16 || k == reflect.Uint32 || k == reflect.Uint64 || k == reflect.Uintptr
+}
+
+// toBigFloat converts the given numeric value to an exact big.Float representation.
+//
+//go:noinline
+func toBigFloat(t T, v any) *big.Float {
+	GetHelper(t).Helper()
+	rv := reflect.ValueOf(v)
+	switch {
+	case isSignedKind(rv.Kind()):
+		return new(big.Float).SetInt64(rv.Int())
+	case isUnsignedKind(rv.Kind()):
+		return new(big.Float).SetUint64(rv.Uint())
+	case rv.Kind() == reflect.Float32 || rv.Kind() == reflect.Float64:
+		if math.IsNaN(rv.Float()) {
+			t.Fatalf("Value %v cannot be compared, since it is NaN", v)
+		}
+		return new(big.Float).SetFloat64(rv.Float())
+	default:
+		t.Fatalf("Type '%T' of value '%+v' is not numeric", v, v)
 		panic("unreachable")
 	}
 }
+
+// toFloat64 converts the given value to a float64, if it is of a numeric kind (including named numeric types).
+//
+//go:noinline
+func toFloat64(v any) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return math.NaN(), false
+	}
+}
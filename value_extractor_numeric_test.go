@@ -7,6 +7,7 @@ import (
 
 	. "github.com/arikkfir/justest"
 	. "github.com/arikkfir/justest/internal"
+	. "github.com/arikkfir/justest/justesttest"
 )
 
 func TestNumericValueExtractor(t *testing.T) {
@@ -36,6 +37,7 @@ func TestNumericValueExtractor(t *testing.T) {
 		"uint16":               {actual: uint16(1), verifier: SuccessVerifier(), expected: uint16(1)},
 		"uint32":               {actual: uint32(1), verifier: SuccessVerifier(), expected: uint32(1)},
 		"uint64":               {actual: uint64(1), verifier: SuccessVerifier(), expected: uint64(1)},
+		"uintptr":              {actual: uintptr(1), verifier: SuccessVerifier(), expected: uintptr(1)},
 	}
 	for name, tc := range testCases {
 		tc := tc